@@ -2,8 +2,8 @@ package main
 
 import (
 	"fmt"
-	"time"
 
+	"github.com/cozely/platform/loop"
 	"github.com/cozely/platform/window"
 )
 
@@ -19,10 +19,22 @@ func main() {
 		panic(err)
 	}
 	fmt.Println(window.InfoString())
-	w.Present()
 
 	fmt.Print("Window opened...")
-	time.Sleep(4 * time.Second)
+
+	// Run for four seconds, or until the window is closed
+	t := 0.0
+	update := func(dt float64) {
+		t += dt
+		if t >= 4 {
+			loop.Stop()
+		}
+	}
+	render := func(alpha float64) {}
+	err = loop.Run(w, update, render)
+	if err != nil {
+		panic(err)
+	}
 
 	w.Close()
 	fmt.Println(" and closed.")
@@ -0,0 +1,39 @@
+package gamepad
+
+import "github.com/cozely/platform/internal/sdl"
+
+// Button identifies a button in the standardized gamepad layout (similar to
+// the XBox 360 controller).
+type Button int32
+
+// Gamepad buttons.
+const (
+	ButtonA             = Button(sdl.ControllerButtonA)
+	ButtonB             = Button(sdl.ControllerButtonB)
+	ButtonX             = Button(sdl.ControllerButtonX)
+	ButtonY             = Button(sdl.ControllerButtonY)
+	ButtonBack          = Button(sdl.ControllerButtonBack)
+	ButtonGuide         = Button(sdl.ControllerButtonGuide)
+	ButtonStart         = Button(sdl.ControllerButtonStart)
+	ButtonLeftStick     = Button(sdl.ControllerButtonLeftStick)
+	ButtonRightStick    = Button(sdl.ControllerButtonRightStick)
+	ButtonLeftShoulder  = Button(sdl.ControllerButtonLeftShoulder)
+	ButtonRightShoulder = Button(sdl.ControllerButtonRightShoulder)
+	ButtonDPadUp        = Button(sdl.ControllerButtonDPadUp)
+	ButtonDPadDown      = Button(sdl.ControllerButtonDPadDown)
+	ButtonDPadLeft      = Button(sdl.ControllerButtonDPadLeft)
+	ButtonDPadRight     = Button(sdl.ControllerButtonDPadRight)
+)
+
+// Axis identifies an axis in the standardized gamepad layout.
+type Axis int32
+
+// Gamepad axes.
+const (
+	AxisLeftX        = Axis(sdl.ControllerAxisLeftX)
+	AxisLeftY        = Axis(sdl.ControllerAxisLeftY)
+	AxisRightX       = Axis(sdl.ControllerAxisRightX)
+	AxisRightY       = Axis(sdl.ControllerAxisRightY)
+	AxisTriggerLeft  = Axis(sdl.ControllerAxisTriggerLeft)
+	AxisTriggerRight = Axis(sdl.ControllerAxisTriggerRight)
+)
@@ -0,0 +1,81 @@
+package gamepad
+
+import (
+	"github.com/cozely/platform/internal/events"
+	"github.com/cozely/platform/internal/sdl"
+)
+
+// Added is sent when a gamepad is connected (including the gamepads already
+// connected when Init is called). The gamepad is opened automatically.
+type Added struct {
+	Gamepad *Gamepad
+}
+
+// Removed is sent when a gamepad is disconnected. The gamepad is closed
+// automatically.
+type Removed struct {
+	Gamepad *Gamepad
+}
+
+// ButtonDown is sent when a button of a gamepad is pressed.
+type ButtonDown struct {
+	Gamepad *Gamepad
+	Button  Button
+}
+
+// ButtonUp is sent when a button of a gamepad is released.
+type ButtonUp struct {
+	Gamepad *Gamepad
+	Button  Button
+}
+
+// AxisMotion is sent when an axis of a gamepad moves. Value has the dead zone
+// applied (see Gamepad.Axis).
+type AxisMotion struct {
+	Gamepad *Gamepad
+	Axis    Axis
+	Value   float32
+}
+
+func init() {
+	events.Register(translate)
+}
+
+func translate(e *sdl.Event) interface{} {
+	switch e.Type {
+	case sdl.EventControllerDeviceAdded:
+		g, err := open(e.CDevice().Which)
+		if err != nil {
+			return nil
+		}
+		return Added{Gamepad: g}
+
+	case sdl.EventControllerDeviceRemoved:
+		g := gamepads[e.CDevice().Which]
+		if g == nil {
+			return nil
+		}
+		g.close()
+		return Removed{Gamepad: g}
+
+	case sdl.EventControllerButtonDown, sdl.EventControllerButtonUp:
+		b := e.CButton()
+		g := gamepads[b.Which]
+		if g == nil {
+			return nil
+		}
+		if e.Type == sdl.EventControllerButtonDown {
+			return ButtonDown{Gamepad: g, Button: Button(b.Button)}
+		}
+		return ButtonUp{Gamepad: g, Button: Button(b.Button)}
+
+	case sdl.EventControllerAxisMotion:
+		a := e.CAxis()
+		g := gamepads[a.Which]
+		if g == nil {
+			return nil
+		}
+		return AxisMotion{Gamepad: g, Axis: Axis(a.Axis), Value: g.filter(a.Value)}
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2013-2018 Laurent Moussault. All rights reserved.
+// Licensed under a simplified BSD license (see LICENSE file).
+
+// Package gamepad provides support for game controllers, with a standardized
+// layout of buttons and axes. Controllers are opened and closed as they are
+// plugged in and out, while window.PollEvents is called.
+package gamepad
+
+import (
+	"errors"
+	"time"
+
+	"github.com/cozely/platform/internal/sdl"
+)
+
+// DefaultDeadZone is the dead zone given to newly connected gamepads.
+const DefaultDeadZone = 0.15
+
+// maxDeadZone is the largest dead zone accepted by SetDeadZone; the axes must
+// keep some range outside of it.
+const maxDeadZone = 0.99
+
+// A Gamepad is a connected game controller.
+type Gamepad struct {
+	controller sdl.GameController
+	haptic     sdl.Haptic
+	hasHaptic  bool
+	id         int32
+	name       string
+	deadZone   float32
+	connected  bool
+}
+
+var gamepads = map[int32]*Gamepad{}
+
+// Init initializes the game controller and haptic subsystems. The gamepads
+// already connected are reported by Added events during the next poll.
+func Init() error {
+	if sdl.WasInit(sdl.InitGamecontroller) == 0 {
+		err := sdl.InitSubSystem(sdl.InitGamecontroller)
+		if err != nil {
+			return err
+		}
+	}
+	if sdl.WasInit(sdl.InitHaptic) == 0 {
+		// Rumble falls back to the controller API when haptic is missing.
+		_ = sdl.InitSubSystem(sdl.InitHaptic)
+	}
+	return nil
+}
+
+// List returns the currently connected gamepads, in no particular order.
+func List() []*Gamepad {
+	l := make([]*Gamepad, 0, len(gamepads))
+	for _, g := range gamepads {
+		l = append(l, g)
+	}
+	return l
+}
+
+func open(index int32) (*Gamepad, error) {
+	c, err := sdl.GameControllerOpen(index)
+	if err != nil {
+		return nil, err
+	}
+
+	j := sdl.GameControllerGetJoystick(c)
+	g := &Gamepad{
+		controller: c,
+		id:         sdl.JoystickInstanceID(j),
+		name:       sdl.GameControllerName(c),
+		deadZone:   DefaultDeadZone,
+		connected:  true,
+	}
+
+	h, err := sdl.HapticOpenFromJoystick(j)
+	if err == nil {
+		if sdl.HapticRumbleInit(h) == nil {
+			g.haptic = h
+			g.hasHaptic = true
+		} else {
+			sdl.HapticClose(h)
+		}
+	}
+
+	gamepads[g.id] = g
+	return g, nil
+}
+
+func (g *Gamepad) close() {
+	if g.hasHaptic {
+		sdl.HapticClose(g.haptic)
+		g.hasHaptic = false
+	}
+	sdl.GameControllerClose(g.controller)
+	g.connected = false
+	delete(gamepads, g.id)
+}
+
+// Name returns the implementation-dependent name of the gamepad.
+func (g *Gamepad) Name() string {
+	return g.name
+}
+
+// Connected returns false once the gamepad has been removed.
+func (g *Gamepad) Connected() bool {
+	return g.connected
+}
+
+// SetDeadZone changes the dead zone applied to the axes of the gamepad, as a
+// fraction of their range. The value is clamped between 0 and 0.99.
+func (g *Gamepad) SetDeadZone(d float32) {
+	g.deadZone = clamp(d, 0, maxDeadZone)
+}
+
+// IsPressed returns true if button b is currently pressed.
+func (g *Gamepad) IsPressed(b Button) bool {
+	if !g.connected {
+		return false
+	}
+	return sdl.GameControllerGetButton(g.controller, int32(b)) != 0
+}
+
+// Axis returns the current value of axis a, after the dead zone is applied.
+// The sticks are in the range [-1, 1], and the triggers in [0, 1].
+func (g *Gamepad) Axis(a Axis) float32 {
+	if !g.connected {
+		return 0
+	}
+	return g.filter(sdl.GameControllerGetAxis(g.controller, int32(a)))
+}
+
+// filter normalizes an axis value and applies the dead zone, rescaling the
+// rest of the range so that there is no jump at its border.
+func (g *Gamepad) filter(v int16) float32 {
+	f := float32(v) / 32767
+	if f < -1 {
+		f = -1
+	}
+	switch {
+	case f > g.deadZone:
+		return (f - g.deadZone) / (1 - g.deadZone)
+	case f < -g.deadZone:
+		return (f + g.deadZone) / (1 - g.deadZone)
+	}
+	return 0
+}
+
+// Rumble starts a rumble effect of the given strength (between 0 and 1), for
+// duration d. The haptic subsystem is used when the gamepad supports it.
+func (g *Gamepad) Rumble(strength float32, d time.Duration) error {
+	if !g.connected {
+		return errors.New("gamepad.Rumble: gamepad disconnected")
+	}
+	strength = clamp(strength, 0, 1)
+	ms := uint32(d / time.Millisecond)
+	if g.hasHaptic {
+		return sdl.HapticRumblePlay(g.haptic, strength, ms)
+	}
+	s := uint16(strength * 0xFFFF)
+	return sdl.GameControllerRumble(g.controller, s, s, ms)
+}
+
+// clamp returns v restricted to the range [min, max].
+func clamp(v, min, max float32) float32 {
+	switch {
+	case v < min:
+		return min
+	case v > max:
+		return max
+	}
+	return v
+}
+
+// StopRumble stops the current rumble effect.
+func (g *Gamepad) StopRumble() error {
+	if !g.connected {
+		return errors.New("gamepad.StopRumble: gamepad disconnected")
+	}
+	if g.hasHaptic {
+		return sdl.HapticRumbleStop(g.haptic)
+	}
+	return sdl.GameControllerRumble(g.controller, 0, 0, 0)
+}
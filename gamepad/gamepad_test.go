@@ -0,0 +1,134 @@
+package gamepad
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFilter(t *testing.T) {
+	tests := []struct {
+		deadZone float32
+		v        int16
+		want     float32
+	}{
+		{0, 0, 0},
+		{0, 32767, 1},
+		{0, -32768, -1},
+		{0, -32767, -1},
+		{0.2, 3000, 0},
+		{0.2, -3000, 0},
+		{0.2, 32767, 1},
+		{0.2, -32768, -1},
+		{0.5, 24575, 0.5}, // 0.75 is halfway between the dead zone and 1
+		{0.5, -24575, -0.5},
+	}
+	for _, tt := range tests {
+		g := Gamepad{deadZone: tt.deadZone}
+		got := g.filter(tt.v)
+		if d := got - tt.want; d > 1e-4 || d < -1e-4 {
+			t.Errorf("filter(%d) with dead zone %v = %v, want %v", tt.v, tt.deadZone, got, tt.want)
+		}
+	}
+}
+
+func TestSetDeadZone(t *testing.T) {
+	tests := []struct {
+		deadZone float32
+		v        int16
+		want     float32
+	}{
+		{-0.5, 3000, 3000.0 / 32767},
+		{1, 32000, 0},
+		{1, 32767, 1},
+		{2, -32768, -1},
+	}
+	for _, tt := range tests {
+		var g Gamepad
+		g.SetDeadZone(tt.deadZone)
+		got := g.filter(tt.v)
+		if d := got - tt.want; d > 1e-4 || d < -1e-4 {
+			t.Errorf("filter(%d) after SetDeadZone(%v) = %v, want %v", tt.v, tt.deadZone, got, tt.want)
+		}
+	}
+}
+
+const (
+	xbox    = "030000005e0400008e02000000000000,Xbox 360,a:b0,b:b1,platform:Linux,"
+	xboxWin = "030000005e0400008e02000000000000,Xbox 360,a:b0,b:b1,platform:Windows,"
+	generic = "03000000ffff00000000000000000000,Generic,a:b0,b:b1,"
+)
+
+func TestParseMappings(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		platform string
+		want     []string
+	}{
+		{"empty", "", "Linux", nil},
+		{"comments and blank lines", "# Game controllers\n\n   \n" + xbox + "\n", "Linux", []string{xbox}},
+		{"other platform", xbox + "\n" + xboxWin + "\n", "Windows", []string{xboxWin}},
+		{"no platform field", generic + "\n", "Mac OS X", []string{generic}},
+		{"platform as last field", strings.TrimSuffix(xbox, ","), "Linux", []string{strings.TrimSuffix(xbox, ",")}},
+		{"surrounding spaces", "  " + xbox + "\t\r\n", "Linux", []string{xbox}},
+	}
+	for _, tt := range tests {
+		got, err := parseMappings(strings.NewReader(tt.input), tt.platform)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+type failingReader struct {
+	data string
+	err  error
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if r.data == "" {
+		return 0, r.err
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+func TestAddMappingsErrors(t *testing.T) {
+	defer func(f func(string) (bool, error)) { addMapping = f }(addMapping)
+
+	var added []string
+	errSDL := errors.New("invalid mapping")
+	addMapping = func(m string) (bool, error) {
+		if strings.HasPrefix(m, "bad") {
+			return false, errSDL
+		}
+		added = append(added, m)
+		return true, nil
+	}
+
+	// Platform filtering is tested with parseMappings: the mappings here have
+	// no platform field
+	n, err := AddMappings(strings.NewReader(generic + "\nbad,mapping\n" + generic))
+	if n != 1 || err != errSDL {
+		t.Errorf("AddMappings with an invalid mapping = %d, %v, want 1, %v", n, err, errSDL)
+	}
+
+	added = nil
+	errRead := errors.New("read failure")
+	n, err = AddMappings(&failingReader{data: generic + "\n", err: errRead})
+	if n != 1 || err != errRead || len(added) != 1 {
+		t.Errorf("AddMappings with a read error = %d, %v, want 1, %v", n, err, errRead)
+	}
+
+	n, err = AddMappings(&failingReader{err: io.EOF})
+	if n != 0 || err != nil {
+		t.Errorf("AddMappings with no input = %d, %v, want 0, nil", n, err)
+	}
+}
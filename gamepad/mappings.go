@@ -0,0 +1,61 @@
+package gamepad
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/cozely/platform/internal/sdl"
+)
+
+// LoadMappings adds the controller mappings found in a file in the format of
+// gamecontrollerdb.txt. It returns the number of mappings added or updated.
+func LoadMappings(path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	return AddMappings(f)
+}
+
+// addMapping adds a single mapping; it is a variable for the tests.
+var addMapping = sdl.GameControllerAddMapping
+
+// AddMappings adds the controller mappings read from r, one per line, in the
+// format of gamecontrollerdb.txt. Empty lines, comments, and mappings for
+// other platforms are skipped. It returns the number of mappings added or
+// updated.
+func AddMappings(r io.Reader) (int, error) {
+	mm, err := parseMappings(r, sdl.GetPlatform())
+	n := 0
+	for _, m := range mm {
+		_, e := addMapping(m)
+		if e != nil {
+			return n, e
+		}
+		n++
+	}
+	return n, err
+}
+
+// parseMappings returns the mappings read from r that apply to platform. If
+// reading fails, the mappings read so far are returned with the error.
+func parseMappings(r io.Reader, platform string) ([]string, error) {
+	p := "platform:" + platform + ","
+
+	var mm []string
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		l := strings.TrimSpace(s.Text())
+		if l == "" || l[0] == '#' {
+			continue
+		}
+		if strings.Contains(l, "platform:") && !strings.Contains(l+",", p) {
+			continue
+		}
+		mm = append(mm, l)
+	}
+	return mm, s.Err()
+}
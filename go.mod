@@ -1,5 +1,5 @@
 module github.com/cozely/platform
 
-go 1.12
+go 1.13
 
 require golang.org/x/sys v0.0.0-20201112073958-5cba982894dd
@@ -0,0 +1,28 @@
+// Package events lets the platform packages translate the system events they
+// are responsible for, when they are polled by package window.
+package events
+
+import "github.com/cozely/platform/internal/sdl"
+
+// A Translator returns the event corresponding to e, or nil if e is not handled
+// by the translator.
+type Translator func(e *sdl.Event) interface{}
+
+var translators []Translator
+
+// Register adds a translator, tried for each event not handled by package
+// window. It should be called during package initialization.
+func Register(t Translator) {
+	translators = append(translators, t)
+}
+
+// Translate returns the event corresponding to e, as found by the first
+// registered translator that handles it, or nil.
+func Translate(e *sdl.Event) interface{} {
+	for _, t := range translators {
+		if ev := t(e); ev != nil {
+			return ev
+		}
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package gl
+
+import "errors"
+
+// A DebugProc receives the messages of the debug output (KHR_debug).
+type DebugProc func(source, typ Enum, id uint32, severity Enum, message string, userParam uintptr)
+
+var debugProc DebugProc
+
+// ErrNoDebugOutput is returned when the debug output functions are not
+// available.
+var ErrNoDebugOutput = errors.New("gl: debug output not supported (KHR_debug)")
+
+// glDebugMessageCallback is not generated, since its wrapper must convert the
+// callback.
+var glDebugMessageCallback uintptr
+
+// debugNames lists the names of glDebugMessageCallback, core first, then the
+// ES extension.
+var debugNames = []string{"glDebugMessageCallback", "glDebugMessageCallbackKHR"}
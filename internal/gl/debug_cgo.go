@@ -0,0 +1,21 @@
+// +build !windows
+
+package gl
+
+import "C"
+
+import "unsafe"
+
+//export goDebugCallback
+func goDebugCallback(source, typ, id, severity C.uint, length C.int, message *C.char, userParam unsafe.Pointer) {
+	if debugProc == nil {
+		return
+	}
+	var m string
+	if length >= 0 {
+		m = C.GoStringN(message, length)
+	} else {
+		m = C.GoString(message)
+	}
+	debugProc(Enum(source), Enum(typ), uint32(id), Enum(severity), m, uintptr(userParam))
+}
@@ -0,0 +1,2941 @@
+// Code generated by "go run gen.go"; DO NOT EDIT.
+// API: gles2 3.2, extensions: GL_KHR_debug,GL_EXT_sRGB_write_control.
+
+// +build !windows
+
+package gl
+
+/*
+#include <stdint.h>
+#include <stdlib.h>
+
+typedef void * GLDEBUGPROC;
+typedef void * GLDEBUGPROCAMD;
+typedef void * GLDEBUGPROCARB;
+typedef void * GLDEBUGPROCKHR;
+typedef void * GLVULKANPROCNV;
+typedef unsigned int GLbitfield;
+typedef unsigned char GLboolean;
+typedef signed char GLbyte;
+typedef char GLchar;
+typedef char GLcharARB;
+typedef double GLclampd;
+typedef float GLclampf;
+typedef int GLclampx;
+typedef double GLdouble;
+typedef void * GLeglClientBufferEXT;
+typedef void * GLeglImageOES;
+typedef unsigned int GLenum;
+typedef int GLfixed;
+typedef float GLfloat;
+typedef unsigned short GLhalf;
+typedef unsigned short GLhalfNV;
+typedef unsigned int GLhandleARB;
+typedef int GLint;
+typedef int64_t GLint64;
+typedef int64_t GLint64EXT;
+typedef intptr_t GLintptr;
+typedef intptr_t GLintptrARB;
+typedef short GLshort;
+typedef int GLsizei;
+typedef intptr_t GLsizeiptr;
+typedef intptr_t GLsizeiptrARB;
+typedef struct __GLsync * GLsync;
+typedef unsigned char GLubyte;
+typedef unsigned int GLuint;
+typedef uint64_t GLuint64;
+typedef uint64_t GLuint64EXT;
+typedef unsigned short GLushort;
+typedef intptr_t GLvdpauSurfaceNV;
+struct _cl_context;
+struct _cl_event;
+
+static void callActiveShaderProgram(uintptr_t fn, GLuint p0, GLuint p1) {
+	((void (*)(GLuint, GLuint))fn)(p0, p1);
+}
+static void callActiveTexture(uintptr_t fn, GLenum p0) {
+	((void (*)(GLenum))fn)(p0);
+}
+static void callAttachShader(uintptr_t fn, GLuint p0, GLuint p1) {
+	((void (*)(GLuint, GLuint))fn)(p0, p1);
+}
+static void callBeginQuery(uintptr_t fn, GLenum p0, GLuint p1) {
+	((void (*)(GLenum, GLuint))fn)(p0, p1);
+}
+static void callBeginTransformFeedback(uintptr_t fn, GLenum p0) {
+	((void (*)(GLenum))fn)(p0);
+}
+static void callBindAttribLocation(uintptr_t fn, GLuint p0, GLuint p1, void * p2) {
+	((void (*)(GLuint, GLuint, const GLchar *))fn)(p0, p1, p2);
+}
+static void callBindBuffer(uintptr_t fn, GLenum p0, GLuint p1) {
+	((void (*)(GLenum, GLuint))fn)(p0, p1);
+}
+static void callBindBufferBase(uintptr_t fn, GLenum p0, GLuint p1, GLuint p2) {
+	((void (*)(GLenum, GLuint, GLuint))fn)(p0, p1, p2);
+}
+static void callBindBufferRange(uintptr_t fn, GLenum p0, GLuint p1, GLuint p2, GLintptr p3, GLsizeiptr p4) {
+	((void (*)(GLenum, GLuint, GLuint, GLintptr, GLsizeiptr))fn)(p0, p1, p2, p3, p4);
+}
+static void callBindFramebuffer(uintptr_t fn, GLenum p0, GLuint p1) {
+	((void (*)(GLenum, GLuint))fn)(p0, p1);
+}
+static void callBindImageTexture(uintptr_t fn, GLuint p0, GLuint p1, GLint p2, GLboolean p3, GLint p4, GLenum p5, GLenum p6) {
+	((void (*)(GLuint, GLuint, GLint, GLboolean, GLint, GLenum, GLenum))fn)(p0, p1, p2, p3, p4, p5, p6);
+}
+static void callBindProgramPipeline(uintptr_t fn, GLuint p0) {
+	((void (*)(GLuint))fn)(p0);
+}
+static void callBindRenderbuffer(uintptr_t fn, GLenum p0, GLuint p1) {
+	((void (*)(GLenum, GLuint))fn)(p0, p1);
+}
+static void callBindSampler(uintptr_t fn, GLuint p0, GLuint p1) {
+	((void (*)(GLuint, GLuint))fn)(p0, p1);
+}
+static void callBindTexture(uintptr_t fn, GLenum p0, GLuint p1) {
+	((void (*)(GLenum, GLuint))fn)(p0, p1);
+}
+static void callBindTransformFeedback(uintptr_t fn, GLenum p0, GLuint p1) {
+	((void (*)(GLenum, GLuint))fn)(p0, p1);
+}
+static void callBindVertexArray(uintptr_t fn, GLuint p0) {
+	((void (*)(GLuint))fn)(p0);
+}
+static void callBindVertexBuffer(uintptr_t fn, GLuint p0, GLuint p1, GLintptr p2, GLsizei p3) {
+	((void (*)(GLuint, GLuint, GLintptr, GLsizei))fn)(p0, p1, p2, p3);
+}
+static void callBlendBarrier(uintptr_t fn) {
+	((void (*)(void))fn)();
+}
+static void callBlendColor(uintptr_t fn, GLfloat p0, GLfloat p1, GLfloat p2, GLfloat p3) {
+	((void (*)(GLfloat, GLfloat, GLfloat, GLfloat))fn)(p0, p1, p2, p3);
+}
+static void callBlendEquation(uintptr_t fn, GLenum p0) {
+	((void (*)(GLenum))fn)(p0);
+}
+static void callBlendEquationSeparate(uintptr_t fn, GLenum p0, GLenum p1) {
+	((void (*)(GLenum, GLenum))fn)(p0, p1);
+}
+static void callBlendEquationSeparatei(uintptr_t fn, GLuint p0, GLenum p1, GLenum p2) {
+	((void (*)(GLuint, GLenum, GLenum))fn)(p0, p1, p2);
+}
+static void callBlendEquationi(uintptr_t fn, GLuint p0, GLenum p1) {
+	((void (*)(GLuint, GLenum))fn)(p0, p1);
+}
+static void callBlendFunc(uintptr_t fn, GLenum p0, GLenum p1) {
+	((void (*)(GLenum, GLenum))fn)(p0, p1);
+}
+static void callBlendFuncSeparate(uintptr_t fn, GLenum p0, GLenum p1, GLenum p2, GLenum p3) {
+	((void (*)(GLenum, GLenum, GLenum, GLenum))fn)(p0, p1, p2, p3);
+}
+static void callBlendFuncSeparatei(uintptr_t fn, GLuint p0, GLenum p1, GLenum p2, GLenum p3, GLenum p4) {
+	((void (*)(GLuint, GLenum, GLenum, GLenum, GLenum))fn)(p0, p1, p2, p3, p4);
+}
+static void callBlendFunci(uintptr_t fn, GLuint p0, GLenum p1, GLenum p2) {
+	((void (*)(GLuint, GLenum, GLenum))fn)(p0, p1, p2);
+}
+static void callBlitFramebuffer(uintptr_t fn, GLint p0, GLint p1, GLint p2, GLint p3, GLint p4, GLint p5, GLint p6, GLint p7, GLbitfield p8, GLenum p9) {
+	((void (*)(GLint, GLint, GLint, GLint, GLint, GLint, GLint, GLint, GLbitfield, GLenum))fn)(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9);
+}
+static void callBufferData(uintptr_t fn, GLenum p0, GLsizeiptr p1, void * p2, GLenum p3) {
+	((void (*)(GLenum, GLsizeiptr, const void *, GLenum))fn)(p0, p1, p2, p3);
+}
+static void callBufferSubData(uintptr_t fn, GLenum p0, GLintptr p1, GLsizeiptr p2, void * p3) {
+	((void (*)(GLenum, GLintptr, GLsizeiptr, const void *))fn)(p0, p1, p2, p3);
+}
+static GLenum callCheckFramebufferStatus(uintptr_t fn, GLenum p0) {
+	return ((GLenum (*)(GLenum))fn)(p0);
+}
+static void callClear(uintptr_t fn, GLbitfield p0) {
+	((void (*)(GLbitfield))fn)(p0);
+}
+static void callClearBufferfi(uintptr_t fn, GLenum p0, GLint p1, GLfloat p2, GLint p3) {
+	((void (*)(GLenum, GLint, GLfloat, GLint))fn)(p0, p1, p2, p3);
+}
+static void callClearBufferfv(uintptr_t fn, GLenum p0, GLint p1, void * p2) {
+	((void (*)(GLenum, GLint, const GLfloat *))fn)(p0, p1, p2);
+}
+static void callClearBufferiv(uintptr_t fn, GLenum p0, GLint p1, void * p2) {
+	((void (*)(GLenum, GLint, const GLint *))fn)(p0, p1, p2);
+}
+static void callClearBufferuiv(uintptr_t fn, GLenum p0, GLint p1, void * p2) {
+	((void (*)(GLenum, GLint, const GLuint *))fn)(p0, p1, p2);
+}
+static void callClearColor(uintptr_t fn, GLfloat p0, GLfloat p1, GLfloat p2, GLfloat p3) {
+	((void (*)(GLfloat, GLfloat, GLfloat, GLfloat))fn)(p0, p1, p2, p3);
+}
+static void callClearDepthf(uintptr_t fn, GLfloat p0) {
+	((void (*)(GLfloat))fn)(p0);
+}
+static void callClearStencil(uintptr_t fn, GLint p0) {
+	((void (*)(GLint))fn)(p0);
+}
+static GLenum callClientWaitSync(uintptr_t fn, uintptr_t p0, GLbitfield p1, GLuint64 p2) {
+	return ((GLenum (*)(GLsync, GLbitfield, GLuint64))fn)((GLsync)p0, p1, p2);
+}
+static void callColorMask(uintptr_t fn, GLboolean p0, GLboolean p1, GLboolean p2, GLboolean p3) {
+	((void (*)(GLboolean, GLboolean, GLboolean, GLboolean))fn)(p0, p1, p2, p3);
+}
+static void callColorMaski(uintptr_t fn, GLuint p0, GLboolean p1, GLboolean p2, GLboolean p3, GLboolean p4) {
+	((void (*)(GLuint, GLboolean, GLboolean, GLboolean, GLboolean))fn)(p0, p1, p2, p3, p4);
+}
+static void callCompileShader(uintptr_t fn, GLuint p0) {
+	((void (*)(GLuint))fn)(p0);
+}
+static void callCompressedTexImage2D(uintptr_t fn, GLenum p0, GLint p1, GLenum p2, GLsizei p3, GLsizei p4, GLint p5, GLsizei p6, void * p7) {
+	((void (*)(GLenum, GLint, GLenum, GLsizei, GLsizei, GLint, GLsizei, const void *))fn)(p0, p1, p2, p3, p4, p5, p6, p7);
+}
+static void callCompressedTexImage3D(uintptr_t fn, GLenum p0, GLint p1, GLenum p2, GLsizei p3, GLsizei p4, GLsizei p5, GLint p6, GLsizei p7, void * p8) {
+	((void (*)(GLenum, GLint, GLenum, GLsizei, GLsizei, GLsizei, GLint, GLsizei, const void *))fn)(p0, p1, p2, p3, p4, p5, p6, p7, p8);
+}
+static void callCompressedTexSubImage2D(uintptr_t fn, GLenum p0, GLint p1, GLint p2, GLint p3, GLsizei p4, GLsizei p5, GLenum p6, GLsizei p7, void * p8) {
+	((void (*)(GLenum, GLint, GLint, GLint, GLsizei, GLsizei, GLenum, GLsizei, const void *))fn)(p0, p1, p2, p3, p4, p5, p6, p7, p8);
+}
+static void callCompressedTexSubImage3D(uintptr_t fn, GLenum p0, GLint p1, GLint p2, GLint p3, GLint p4, GLsizei p5, GLsizei p6, GLsizei p7, GLenum p8, GLsizei p9, void * p10) {
+	((void (*)(GLenum, GLint, GLint, GLint, GLint, GLsizei, GLsizei, GLsizei, GLenum, GLsizei, const void *))fn)(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, p10);
+}
+static void callCopyBufferSubData(uintptr_t fn, GLenum p0, GLenum p1, GLintptr p2, GLintptr p3, GLsizeiptr p4) {
+	((void (*)(GLenum, GLenum, GLintptr, GLintptr, GLsizeiptr))fn)(p0, p1, p2, p3, p4);
+}
+static void callCopyImageSubData(uintptr_t fn, GLuint p0, GLenum p1, GLint p2, GLint p3, GLint p4, GLint p5, GLuint p6, GLenum p7, GLint p8, GLint p9, GLint p10, GLint p11, GLsizei p12, GLsizei p13, GLsizei p14) {
+	((void (*)(GLuint, GLenum, GLint, GLint, GLint, GLint, GLuint, GLenum, GLint, GLint, GLint, GLint, GLsizei, GLsizei, GLsizei))fn)(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, p10, p11, p12, p13, p14);
+}
+static void callCopyTexImage2D(uintptr_t fn, GLenum p0, GLint p1, GLenum p2, GLint p3, GLint p4, GLsizei p5, GLsizei p6, GLint p7) {
+	((void (*)(GLenum, GLint, GLenum, GLint, GLint, GLsizei, GLsizei, GLint))fn)(p0, p1, p2, p3, p4, p5, p6, p7);
+}
+static void callCopyTexSubImage2D(uintptr_t fn, GLenum p0, GLint p1, GLint p2, GLint p3, GLint p4, GLint p5, GLsizei p6, GLsizei p7) {
+	((void (*)(GLenum, GLint, GLint, GLint, GLint, GLint, GLsizei, GLsizei))fn)(p0, p1, p2, p3, p4, p5, p6, p7);
+}
+static void callCopyTexSubImage3D(uintptr_t fn, GLenum p0, GLint p1, GLint p2, GLint p3, GLint p4, GLint p5, GLint p6, GLsizei p7, GLsizei p8) {
+	((void (*)(GLenum, GLint, GLint, GLint, GLint, GLint, GLint, GLsizei, GLsizei))fn)(p0, p1, p2, p3, p4, p5, p6, p7, p8);
+}
+static GLuint callCreateProgram(uintptr_t fn) {
+	return ((GLuint (*)(void))fn)();
+}
+static GLuint callCreateShader(uintptr_t fn, GLenum p0) {
+	return ((GLuint (*)(GLenum))fn)(p0);
+}
+static GLuint callCreateShaderProgramv(uintptr_t fn, GLenum p0, GLsizei p1, void * p2) {
+	return ((GLuint (*)(GLenum, GLsizei, const GLchar *const*))fn)(p0, p1, p2);
+}
+static void callCullFace(uintptr_t fn, GLenum p0) {
+	((void (*)(GLenum))fn)(p0);
+}
+static void callDebugMessageControl(uintptr_t fn, GLenum p0, GLenum p1, GLenum p2, GLsizei p3, void * p4, GLboolean p5) {
+	((void (*)(GLenum, GLenum, GLenum, GLsizei, const GLuint *, GLboolean))fn)(p0, p1, p2, p3, p4, p5);
+}
+static void callDebugMessageInsert(uintptr_t fn, GLenum p0, GLenum p1, GLuint p2, GLenum p3, GLsizei p4, void * p5) {
+	((void (*)(GLenum, GLenum, GLuint, GLenum, GLsizei, const GLchar *))fn)(p0, p1, p2, p3, p4, p5);
+}
+static void callDeleteBuffers(uintptr_t fn, GLsizei p0, void * p1) {
+	((void (*)(GLsizei, const GLuint *))fn)(p0, p1);
+}
+static void callDeleteFramebuffers(uintptr_t fn, GLsizei p0, void * p1) {
+	((void (*)(GLsizei, const GLuint *))fn)(p0, p1);
+}
+static void callDeleteProgram(uintptr_t fn, GLuint p0) {
+	((void (*)(GLuint))fn)(p0);
+}
+static void callDeleteProgramPipelines(uintptr_t fn, GLsizei p0, void * p1) {
+	((void (*)(GLsizei, const GLuint *))fn)(p0, p1);
+}
+static void callDeleteQueries(uintptr_t fn, GLsizei p0, void * p1) {
+	((void (*)(GLsizei, const GLuint *))fn)(p0, p1);
+}
+static void callDeleteRenderbuffers(uintptr_t fn, GLsizei p0, void * p1) {
+	((void (*)(GLsizei, const GLuint *))fn)(p0, p1);
+}
+static void callDeleteSamplers(uintptr_t fn, GLsizei p0, void * p1) {
+	((void (*)(GLsizei, const GLuint *))fn)(p0, p1);
+}
+static void callDeleteShader(uintptr_t fn, GLuint p0) {
+	((void (*)(GLuint))fn)(p0);
+}
+static void callDeleteSync(uintptr_t fn, uintptr_t p0) {
+	((void (*)(GLsync))fn)((GLsync)p0);
+}
+static void callDeleteTextures(uintptr_t fn, GLsizei p0, void * p1) {
+	((void (*)(GLsizei, const GLuint *))fn)(p0, p1);
+}
+static void callDeleteTransformFeedbacks(uintptr_t fn, GLsizei p0, void * p1) {
+	((void (*)(GLsizei, const GLuint *))fn)(p0, p1);
+}
+static void callDeleteVertexArrays(uintptr_t fn, GLsizei p0, void * p1) {
+	((void (*)(GLsizei, const GLuint *))fn)(p0, p1);
+}
+static void callDepthFunc(uintptr_t fn, GLenum p0) {
+	((void (*)(GLenum))fn)(p0);
+}
+static void callDepthMask(uintptr_t fn, GLboolean p0) {
+	((void (*)(GLboolean))fn)(p0);
+}
+static void callDepthRangef(uintptr_t fn, GLfloat p0, GLfloat p1) {
+	((void (*)(GLfloat, GLfloat))fn)(p0, p1);
+}
+static void callDetachShader(uintptr_t fn, GLuint p0, GLuint p1) {
+	((void (*)(GLuint, GLuint))fn)(p0, p1);
+}
+static void callDisable(uintptr_t fn, GLenum p0) {
+	((void (*)(GLenum))fn)(p0);
+}
+static void callDisableVertexAttribArray(uintptr_t fn, GLuint p0) {
+	((void (*)(GLuint))fn)(p0);
+}
+static void callDisablei(uintptr_t fn, GLenum p0, GLuint p1) {
+	((void (*)(GLenum, GLuint))fn)(p0, p1);
+}
+static void callDispatchCompute(uintptr_t fn, GLuint p0, GLuint p1, GLuint p2) {
+	((void (*)(GLuint, GLuint, GLuint))fn)(p0, p1, p2);
+}
+static void callDispatchComputeIndirect(uintptr_t fn, GLintptr p0) {
+	((void (*)(GLintptr))fn)(p0);
+}
+static void callDrawArrays(uintptr_t fn, GLenum p0, GLint p1, GLsizei p2) {
+	((void (*)(GLenum, GLint, GLsizei))fn)(p0, p1, p2);
+}
+static void callDrawArraysIndirect(uintptr_t fn, GLenum p0, void * p1) {
+	((void (*)(GLenum, const void *))fn)(p0, p1);
+}
+static void callDrawArraysInstanced(uintptr_t fn, GLenum p0, GLint p1, GLsizei p2, GLsizei p3) {
+	((void (*)(GLenum, GLint, GLsizei, GLsizei))fn)(p0, p1, p2, p3);
+}
+static void callDrawBuffers(uintptr_t fn, GLsizei p0, void * p1) {
+	((void (*)(GLsizei, const GLenum *))fn)(p0, p1);
+}
+static void callDrawElements(uintptr_t fn, GLenum p0, GLsizei p1, GLenum p2, void * p3) {
+	((void (*)(GLenum, GLsizei, GLenum, const void *))fn)(p0, p1, p2, p3);
+}
+static void callDrawElementsBaseVertex(uintptr_t fn, GLenum p0, GLsizei p1, GLenum p2, void * p3, GLint p4) {
+	((void (*)(GLenum, GLsizei, GLenum, const void *, GLint))fn)(p0, p1, p2, p3, p4);
+}
+static void callDrawElementsIndirect(uintptr_t fn, GLenum p0, GLenum p1, void * p2) {
+	((void (*)(GLenum, GLenum, const void *))fn)(p0, p1, p2);
+}
+static void callDrawElementsInstanced(uintptr_t fn, GLenum p0, GLsizei p1, GLenum p2, void * p3, GLsizei p4) {
+	((void (*)(GLenum, GLsizei, GLenum, const void *, GLsizei))fn)(p0, p1, p2, p3, p4);
+}
+static void callDrawElementsInstancedBaseVertex(uintptr_t fn, GLenum p0, GLsizei p1, GLenum p2, void * p3, GLsizei p4, GLint p5) {
+	((void (*)(GLenum, GLsizei, GLenum, const void *, GLsizei, GLint))fn)(p0, p1, p2, p3, p4, p5);
+}
+static void callDrawRangeElements(uintptr_t fn, GLenum p0, GLuint p1, GLuint p2, GLsizei p3, GLenum p4, void * p5) {
+	((void (*)(GLenum, GLuint, GLuint, GLsizei, GLenum, const void *))fn)(p0, p1, p2, p3, p4, p5);
+}
+static void callDrawRangeElementsBaseVertex(uintptr_t fn, GLenum p0, GLuint p1, GLuint p2, GLsizei p3, GLenum p4, void * p5, GLint p6) {
+	((void (*)(GLenum, GLuint, GLuint, GLsizei, GLenum, const void *, GLint))fn)(p0, p1, p2, p3, p4, p5, p6);
+}
+static void callEnable(uintptr_t fn, GLenum p0) {
+	((void (*)(GLenum))fn)(p0);
+}
+static void callEnableVertexAttribArray(uintptr_t fn, GLuint p0) {
+	((void (*)(GLuint))fn)(p0);
+}
+static void callEnablei(uintptr_t fn, GLenum p0, GLuint p1) {
+	((void (*)(GLenum, GLuint))fn)(p0, p1);
+}
+static void callEndQuery(uintptr_t fn, GLenum p0) {
+	((void (*)(GLenum))fn)(p0);
+}
+static void callEndTransformFeedback(uintptr_t fn) {
+	((void (*)(void))fn)();
+}
+static uintptr_t callFenceSync(uintptr_t fn, GLenum p0, GLbitfield p1) {
+	return (uintptr_t)((GLsync (*)(GLenum, GLbitfield))fn)(p0, p1);
+}
+static void callFinish(uintptr_t fn) {
+	((void (*)(void))fn)();
+}
+static void callFlush(uintptr_t fn) {
+	((void (*)(void))fn)();
+}
+static void callFlushMappedBufferRange(uintptr_t fn, GLenum p0, GLintptr p1, GLsizeiptr p2) {
+	((void (*)(GLenum, GLintptr, GLsizeiptr))fn)(p0, p1, p2);
+}
+static void callFramebufferParameteri(uintptr_t fn, GLenum p0, GLenum p1, GLint p2) {
+	((void (*)(GLenum, GLenum, GLint))fn)(p0, p1, p2);
+}
+static void callFramebufferRenderbuffer(uintptr_t fn, GLenum p0, GLenum p1, GLenum p2, GLuint p3) {
+	((void (*)(GLenum, GLenum, GLenum, GLuint))fn)(p0, p1, p2, p3);
+}
+static void callFramebufferTexture(uintptr_t fn, GLenum p0, GLenum p1, GLuint p2, GLint p3) {
+	((void (*)(GLenum, GLenum, GLuint, GLint))fn)(p0, p1, p2, p3);
+}
+static void callFramebufferTexture2D(uintptr_t fn, GLenum p0, GLenum p1, GLenum p2, GLuint p3, GLint p4) {
+	((void (*)(GLenum, GLenum, GLenum, GLuint, GLint))fn)(p0, p1, p2, p3, p4);
+}
+static void callFramebufferTextureLayer(uintptr_t fn, GLenum p0, GLenum p1, GLuint p2, GLint p3, GLint p4) {
+	((void (*)(GLenum, GLenum, GLuint, GLint, GLint))fn)(p0, p1, p2, p3, p4);
+}
+static void callFrontFace(uintptr_t fn, GLenum p0) {
+	((void (*)(GLenum))fn)(p0);
+}
+static void callGenBuffers(uintptr_t fn, GLsizei p0, void * p1) {
+	((void (*)(GLsizei, GLuint *))fn)(p0, p1);
+}
+static void callGenFramebuffers(uintptr_t fn, GLsizei p0, void * p1) {
+	((void (*)(GLsizei, GLuint *))fn)(p0, p1);
+}
+static void callGenProgramPipelines(uintptr_t fn, GLsizei p0, void * p1) {
+	((void (*)(GLsizei, GLuint *))fn)(p0, p1);
+}
+static void callGenQueries(uintptr_t fn, GLsizei p0, void * p1) {
+	((void (*)(GLsizei, GLuint *))fn)(p0, p1);
+}
+static void callGenRenderbuffers(uintptr_t fn, GLsizei p0, void * p1) {
+	((void (*)(GLsizei, GLuint *))fn)(p0, p1);
+}
+static void callGenSamplers(uintptr_t fn, GLsizei p0, void * p1) {
+	((void (*)(GLsizei, GLuint *))fn)(p0, p1);
+}
+static void callGenTextures(uintptr_t fn, GLsizei p0, void * p1) {
+	((void (*)(GLsizei, GLuint *))fn)(p0, p1);
+}
+static void callGenTransformFeedbacks(uintptr_t fn, GLsizei p0, void * p1) {
+	((void (*)(GLsizei, GLuint *))fn)(p0, p1);
+}
+static void callGenVertexArrays(uintptr_t fn, GLsizei p0, void * p1) {
+	((void (*)(GLsizei, GLuint *))fn)(p0, p1);
+}
+static void callGenerateMipmap(uintptr_t fn, GLenum p0) {
+	((void (*)(GLenum))fn)(p0);
+}
+static void callGetActiveAttrib(uintptr_t fn, GLuint p0, GLuint p1, GLsizei p2, void * p3, void * p4, void * p5, void * p6) {
+	((void (*)(GLuint, GLuint, GLsizei, GLsizei *, GLint *, GLenum *, GLchar *))fn)(p0, p1, p2, p3, p4, p5, p6);
+}
+static void callGetActiveUniform(uintptr_t fn, GLuint p0, GLuint p1, GLsizei p2, void * p3, void * p4, void * p5, void * p6) {
+	((void (*)(GLuint, GLuint, GLsizei, GLsizei *, GLint *, GLenum *, GLchar *))fn)(p0, p1, p2, p3, p4, p5, p6);
+}
+static void callGetActiveUniformBlockName(uintptr_t fn, GLuint p0, GLuint p1, GLsizei p2, void * p3, void * p4) {
+	((void (*)(GLuint, GLuint, GLsizei, GLsizei *, GLchar *))fn)(p0, p1, p2, p3, p4);
+}
+static void callGetActiveUniformBlockiv(uintptr_t fn, GLuint p0, GLuint p1, GLenum p2, void * p3) {
+	((void (*)(GLuint, GLuint, GLenum, GLint *))fn)(p0, p1, p2, p3);
+}
+static void callGetActiveUniformsiv(uintptr_t fn, GLuint p0, GLsizei p1, void * p2, GLenum p3, void * p4) {
+	((void (*)(GLuint, GLsizei, const GLuint *, GLenum, GLint *))fn)(p0, p1, p2, p3, p4);
+}
+static void callGetAttachedShaders(uintptr_t fn, GLuint p0, GLsizei p1, void * p2, void * p3) {
+	((void (*)(GLuint, GLsizei, GLsizei *, GLuint *))fn)(p0, p1, p2, p3);
+}
+static GLint callGetAttribLocation(uintptr_t fn, GLuint p0, void * p1) {
+	return ((GLint (*)(GLuint, const GLchar *))fn)(p0, p1);
+}
+static void callGetBooleani_v(uintptr_t fn, GLenum p0, GLuint p1, void * p2) {
+	((void (*)(GLenum, GLuint, GLboolean *))fn)(p0, p1, p2);
+}
+static void callGetBooleanv(uintptr_t fn, GLenum p0, void * p1) {
+	((void (*)(GLenum, GLboolean *))fn)(p0, p1);
+}
+static void callGetBufferParameteri64v(uintptr_t fn, GLenum p0, GLenum p1, void * p2) {
+	((void (*)(GLenum, GLenum, GLint64 *))fn)(p0, p1, p2);
+}
+static void callGetBufferParameteriv(uintptr_t fn, GLenum p0, GLenum p1, void * p2) {
+	((void (*)(GLenum, GLenum, GLint *))fn)(p0, p1, p2);
+}
+static void callGetBufferPointerv(uintptr_t fn, GLenum p0, GLenum p1, void * p2) {
+	((void (*)(GLenum, GLenum, void **))fn)(p0, p1, p2);
+}
+static GLuint callGetDebugMessageLog(uintptr_t fn, GLuint p0, GLsizei p1, void * p2, void * p3, void * p4, void * p5, void * p6, void * p7) {
+	return ((GLuint (*)(GLuint, GLsizei, GLenum *, GLenum *, GLuint *, GLenum *, GLsizei *, GLchar *))fn)(p0, p1, p2, p3, p4, p5, p6, p7);
+}
+static GLenum callGetError(uintptr_t fn) {
+	return ((GLenum (*)(void))fn)();
+}
+static void callGetFloatv(uintptr_t fn, GLenum p0, void * p1) {
+	((void (*)(GLenum, GLfloat *))fn)(p0, p1);
+}
+static GLint callGetFragDataLocation(uintptr_t fn, GLuint p0, void * p1) {
+	return ((GLint (*)(GLuint, const GLchar *))fn)(p0, p1);
+}
+static void callGetFramebufferAttachmentParameteriv(uintptr_t fn, GLenum p0, GLenum p1, GLenum p2, void * p3) {
+	((void (*)(GLenum, GLenum, GLenum, GLint *))fn)(p0, p1, p2, p3);
+}
+static void callGetFramebufferParameteriv(uintptr_t fn, GLenum p0, GLenum p1, void * p2) {
+	((void (*)(GLenum, GLenum, GLint *))fn)(p0, p1, p2);
+}
+static GLenum callGetGraphicsResetStatus(uintptr_t fn) {
+	return ((GLenum (*)(void))fn)();
+}
+static void callGetInteger64i_v(uintptr_t fn, GLenum p0, GLuint p1, void * p2) {
+	((void (*)(GLenum, GLuint, GLint64 *))fn)(p0, p1, p2);
+}
+static void callGetInteger64v(uintptr_t fn, GLenum p0, void * p1) {
+	((void (*)(GLenum, GLint64 *))fn)(p0, p1);
+}
+static void callGetIntegeri_v(uintptr_t fn, GLenum p0, GLuint p1, void * p2) {
+	((void (*)(GLenum, GLuint, GLint *))fn)(p0, p1, p2);
+}
+static void callGetIntegerv(uintptr_t fn, GLenum p0, void * p1) {
+	((void (*)(GLenum, GLint *))fn)(p0, p1);
+}
+static void callGetInternalformativ(uintptr_t fn, GLenum p0, GLenum p1, GLenum p2, GLsizei p3, void * p4) {
+	((void (*)(GLenum, GLenum, GLenum, GLsizei, GLint *))fn)(p0, p1, p2, p3, p4);
+}
+static void callGetMultisamplefv(uintptr_t fn, GLenum p0, GLuint p1, void * p2) {
+	((void (*)(GLenum, GLuint, GLfloat *))fn)(p0, p1, p2);
+}
+static void callGetObjectLabel(uintptr_t fn, GLenum p0, GLuint p1, GLsizei p2, void * p3, void * p4) {
+	((void (*)(GLenum, GLuint, GLsizei, GLsizei *, GLchar *))fn)(p0, p1, p2, p3, p4);
+}
+static void callGetObjectPtrLabel(uintptr_t fn, void * p0, GLsizei p1, void * p2, void * p3) {
+	((void (*)(const void *, GLsizei, GLsizei *, GLchar *))fn)(p0, p1, p2, p3);
+}
+static void callGetPointerv(uintptr_t fn, GLenum p0, void * p1) {
+	((void (*)(GLenum, void **))fn)(p0, p1);
+}
+static void callGetProgramBinary(uintptr_t fn, GLuint p0, GLsizei p1, void * p2, void * p3, void * p4) {
+	((void (*)(GLuint, GLsizei, GLsizei *, GLenum *, void *))fn)(p0, p1, p2, p3, p4);
+}
+static void callGetProgramInfoLog(uintptr_t fn, GLuint p0, GLsizei p1, void * p2, void * p3) {
+	((void (*)(GLuint, GLsizei, GLsizei *, GLchar *))fn)(p0, p1, p2, p3);
+}
+static void callGetProgramInterfaceiv(uintptr_t fn, GLuint p0, GLenum p1, GLenum p2, void * p3) {
+	((void (*)(GLuint, GLenum, GLenum, GLint *))fn)(p0, p1, p2, p3);
+}
+static void callGetProgramPipelineInfoLog(uintptr_t fn, GLuint p0, GLsizei p1, void * p2, void * p3) {
+	((void (*)(GLuint, GLsizei, GLsizei *, GLchar *))fn)(p0, p1, p2, p3);
+}
+static void callGetProgramPipelineiv(uintptr_t fn, GLuint p0, GLenum p1, void * p2) {
+	((void (*)(GLuint, GLenum, GLint *))fn)(p0, p1, p2);
+}
+static GLuint callGetProgramResourceIndex(uintptr_t fn, GLuint p0, GLenum p1, void * p2) {
+	return ((GLuint (*)(GLuint, GLenum, const GLchar *))fn)(p0, p1, p2);
+}
+static GLint callGetProgramResourceLocation(uintptr_t fn, GLuint p0, GLenum p1, void * p2) {
+	return ((GLint (*)(GLuint, GLenum, const GLchar *))fn)(p0, p1, p2);
+}
+static void callGetProgramResourceName(uintptr_t fn, GLuint p0, GLenum p1, GLuint p2, GLsizei p3, void * p4, void * p5) {
+	((void (*)(GLuint, GLenum, GLuint, GLsizei, GLsizei *, GLchar *))fn)(p0, p1, p2, p3, p4, p5);
+}
+static void callGetProgramResourceiv(uintptr_t fn, GLuint p0, GLenum p1, GLuint p2, GLsizei p3, void * p4, GLsizei p5, void * p6, void * p7) {
+	((void (*)(GLuint, GLenum, GLuint, GLsizei, const GLenum *, GLsizei, GLsizei *, GLint *))fn)(p0, p1, p2, p3, p4, p5, p6, p7);
+}
+static void callGetProgramiv(uintptr_t fn, GLuint p0, GLenum p1, void * p2) {
+	((void (*)(GLuint, GLenum, GLint *))fn)(p0, p1, p2);
+}
+static void callGetQueryObjectuiv(uintptr_t fn, GLuint p0, GLenum p1, void * p2) {
+	((void (*)(GLuint, GLenum, GLuint *))fn)(p0, p1, p2);
+}
+static void callGetQueryiv(uintptr_t fn, GLenum p0, GLenum p1, void * p2) {
+	((void (*)(GLenum, GLenum, GLint *))fn)(p0, p1, p2);
+}
+static void callGetRenderbufferParameteriv(uintptr_t fn, GLenum p0, GLenum p1, void * p2) {
+	((void (*)(GLenum, GLenum, GLint *))fn)(p0, p1, p2);
+}
+static void callGetSamplerParameterIiv(uintptr_t fn, GLuint p0, GLenum p1, void * p2) {
+	((void (*)(GLuint, GLenum, GLint *))fn)(p0, p1, p2);
+}
+static void callGetSamplerParameterIuiv(uintptr_t fn, GLuint p0, GLenum p1, void * p2) {
+	((void (*)(GLuint, GLenum, GLuint *))fn)(p0, p1, p2);
+}
+static void callGetSamplerParameterfv(uintptr_t fn, GLuint p0, GLenum p1, void * p2) {
+	((void (*)(GLuint, GLenum, GLfloat *))fn)(p0, p1, p2);
+}
+static void callGetSamplerParameteriv(uintptr_t fn, GLuint p0, GLenum p1, void * p2) {
+	((void (*)(GLuint, GLenum, GLint *))fn)(p0, p1, p2);
+}
+static void callGetShaderInfoLog(uintptr_t fn, GLuint p0, GLsizei p1, void * p2, void * p3) {
+	((void (*)(GLuint, GLsizei, GLsizei *, GLchar *))fn)(p0, p1, p2, p3);
+}
+static void callGetShaderPrecisionFormat(uintptr_t fn, GLenum p0, GLenum p1, void * p2, void * p3) {
+	((void (*)(GLenum, GLenum, GLint *, GLint *))fn)(p0, p1, p2, p3);
+}
+static void callGetShaderSource(uintptr_t fn, GLuint p0, GLsizei p1, void * p2, void * p3) {
+	((void (*)(GLuint, GLsizei, GLsizei *, GLchar *))fn)(p0, p1, p2, p3);
+}
+static void callGetShaderiv(uintptr_t fn, GLuint p0, GLenum p1, void * p2) {
+	((void (*)(GLuint, GLenum, GLint *))fn)(p0, p1, p2);
+}
+static const char * callGetString(uintptr_t fn, GLenum p0) {
+	return (const char *)((const GLubyte * (*)(GLenum))fn)(p0);
+}
+static const char * callGetStringi(uintptr_t fn, GLenum p0, GLuint p1) {
+	return (const char *)((const GLubyte * (*)(GLenum, GLuint))fn)(p0, p1);
+}
+static void callGetSynciv(uintptr_t fn, uintptr_t p0, GLenum p1, GLsizei p2, void * p3, void * p4) {
+	((void (*)(GLsync, GLenum, GLsizei, GLsizei *, GLint *))fn)((GLsync)p0, p1, p2, p3, p4);
+}
+static void callGetTexLevelParameterfv(uintptr_t fn, GLenum p0, GLint p1, GLenum p2, void * p3) {
+	((void (*)(GLenum, GLint, GLenum, GLfloat *))fn)(p0, p1, p2, p3);
+}
+static void callGetTexLevelParameteriv(uintptr_t fn, GLenum p0, GLint p1, GLenum p2, void * p3) {
+	((void (*)(GLenum, GLint, GLenum, GLint *))fn)(p0, p1, p2, p3);
+}
+static void callGetTexParameterIiv(uintptr_t fn, GLenum p0, GLenum p1, void * p2) {
+	((void (*)(GLenum, GLenum, GLint *))fn)(p0, p1, p2);
+}
+static void callGetTexParameterIuiv(uintptr_t fn, GLenum p0, GLenum p1, void * p2) {
+	((void (*)(GLenum, GLenum, GLuint *))fn)(p0, p1, p2);
+}
+static void callGetTexParameterfv(uintptr_t fn, GLenum p0, GLenum p1, void * p2) {
+	((void (*)(GLenum, GLenum, GLfloat *))fn)(p0, p1, p2);
+}
+static void callGetTexParameteriv(uintptr_t fn, GLenum p0, GLenum p1, void * p2) {
+	((void (*)(GLenum, GLenum, GLint *))fn)(p0, p1, p2);
+}
+static void callGetTransformFeedbackVarying(uintptr_t fn, GLuint p0, GLuint p1, GLsizei p2, void * p3, void * p4, void * p5, void * p6) {
+	((void (*)(GLuint, GLuint, GLsizei, GLsizei *, GLsizei *, GLenum *, GLchar *))fn)(p0, p1, p2, p3, p4, p5, p6);
+}
+static GLuint callGetUniformBlockIndex(uintptr_t fn, GLuint p0, void * p1) {
+	return ((GLuint (*)(GLuint, const GLchar *))fn)(p0, p1);
+}
+static void callGetUniformIndices(uintptr_t fn, GLuint p0, GLsizei p1, void * p2, void * p3) {
+	((void (*)(GLuint, GLsizei, const GLchar *const*, GLuint *))fn)(p0, p1, p2, p3);
+}
+static GLint callGetUniformLocation(uintptr_t fn, GLuint p0, void * p1) {
+	return ((GLint (*)(GLuint, const GLchar *))fn)(p0, p1);
+}
+static void callGetUniformfv(uintptr_t fn, GLuint p0, GLint p1, void * p2) {
+	((void (*)(GLuint, GLint, GLfloat *))fn)(p0, p1, p2);
+}
+static void callGetUniformiv(uintptr_t fn, GLuint p0, GLint p1, void * p2) {
+	((void (*)(GLuint, GLint, GLint *))fn)(p0, p1, p2);
+}
+static void callGetUniformuiv(uintptr_t fn, GLuint p0, GLint p1, void * p2) {
+	((void (*)(GLuint, GLint, GLuint *))fn)(p0, p1, p2);
+}
+static void callGetVertexAttribIiv(uintptr_t fn, GLuint p0, GLenum p1, void * p2) {
+	((void (*)(GLuint, GLenum, GLint *))fn)(p0, p1, p2);
+}
+static void callGetVertexAttribIuiv(uintptr_t fn, GLuint p0, GLenum p1, void * p2) {
+	((void (*)(GLuint, GLenum, GLuint *))fn)(p0, p1, p2);
+}
+static void callGetVertexAttribPointerv(uintptr_t fn, GLuint p0, GLenum p1, void * p2) {
+	((void (*)(GLuint, GLenum, void **))fn)(p0, p1, p2);
+}
+static void callGetVertexAttribfv(uintptr_t fn, GLuint p0, GLenum p1, void * p2) {
+	((void (*)(GLuint, GLenum, GLfloat *))fn)(p0, p1, p2);
+}
+static void callGetVertexAttribiv(uintptr_t fn, GLuint p0, GLenum p1, void * p2) {
+	((void (*)(GLuint, GLenum, GLint *))fn)(p0, p1, p2);
+}
+static void callGetnUniformfv(uintptr_t fn, GLuint p0, GLint p1, GLsizei p2, void * p3) {
+	((void (*)(GLuint, GLint, GLsizei, GLfloat *))fn)(p0, p1, p2, p3);
+}
+static void callGetnUniformiv(uintptr_t fn, GLuint p0, GLint p1, GLsizei p2, void * p3) {
+	((void (*)(GLuint, GLint, GLsizei, GLint *))fn)(p0, p1, p2, p3);
+}
+static void callGetnUniformuiv(uintptr_t fn, GLuint p0, GLint p1, GLsizei p2, void * p3) {
+	((void (*)(GLuint, GLint, GLsizei, GLuint *))fn)(p0, p1, p2, p3);
+}
+static void callHint(uintptr_t fn, GLenum p0, GLenum p1) {
+	((void (*)(GLenum, GLenum))fn)(p0, p1);
+}
+static void callInvalidateFramebuffer(uintptr_t fn, GLenum p0, GLsizei p1, void * p2) {
+	((void (*)(GLenum, GLsizei, const GLenum *))fn)(p0, p1, p2);
+}
+static void callInvalidateSubFramebuffer(uintptr_t fn, GLenum p0, GLsizei p1, void * p2, GLint p3, GLint p4, GLsizei p5, GLsizei p6) {
+	((void (*)(GLenum, GLsizei, const GLenum *, GLint, GLint, GLsizei, GLsizei))fn)(p0, p1, p2, p3, p4, p5, p6);
+}
+static GLboolean callIsBuffer(uintptr_t fn, GLuint p0) {
+	return ((GLboolean (*)(GLuint))fn)(p0);
+}
+static GLboolean callIsEnabled(uintptr_t fn, GLenum p0) {
+	return ((GLboolean (*)(GLenum))fn)(p0);
+}
+static GLboolean callIsEnabledi(uintptr_t fn, GLenum p0, GLuint p1) {
+	return ((GLboolean (*)(GLenum, GLuint))fn)(p0, p1);
+}
+static GLboolean callIsFramebuffer(uintptr_t fn, GLuint p0) {
+	return ((GLboolean (*)(GLuint))fn)(p0);
+}
+static GLboolean callIsProgram(uintptr_t fn, GLuint p0) {
+	return ((GLboolean (*)(GLuint))fn)(p0);
+}
+static GLboolean callIsProgramPipeline(uintptr_t fn, GLuint p0) {
+	return ((GLboolean (*)(GLuint))fn)(p0);
+}
+static GLboolean callIsQuery(uintptr_t fn, GLuint p0) {
+	return ((GLboolean (*)(GLuint))fn)(p0);
+}
+static GLboolean callIsRenderbuffer(uintptr_t fn, GLuint p0) {
+	return ((GLboolean (*)(GLuint))fn)(p0);
+}
+static GLboolean callIsSampler(uintptr_t fn, GLuint p0) {
+	return ((GLboolean (*)(GLuint))fn)(p0);
+}
+static GLboolean callIsShader(uintptr_t fn, GLuint p0) {
+	return ((GLboolean (*)(GLuint))fn)(p0);
+}
+static GLboolean callIsSync(uintptr_t fn, uintptr_t p0) {
+	return ((GLboolean (*)(GLsync))fn)((GLsync)p0);
+}
+static GLboolean callIsTexture(uintptr_t fn, GLuint p0) {
+	return ((GLboolean (*)(GLuint))fn)(p0);
+}
+static GLboolean callIsTransformFeedback(uintptr_t fn, GLuint p0) {
+	return ((GLboolean (*)(GLuint))fn)(p0);
+}
+static GLboolean callIsVertexArray(uintptr_t fn, GLuint p0) {
+	return ((GLboolean (*)(GLuint))fn)(p0);
+}
+static void callLineWidth(uintptr_t fn, GLfloat p0) {
+	((void (*)(GLfloat))fn)(p0);
+}
+static void callLinkProgram(uintptr_t fn, GLuint p0) {
+	((void (*)(GLuint))fn)(p0);
+}
+static void * callMapBufferRange(uintptr_t fn, GLenum p0, GLintptr p1, GLsizeiptr p2, GLbitfield p3) {
+	return ((void * (*)(GLenum, GLintptr, GLsizeiptr, GLbitfield))fn)(p0, p1, p2, p3);
+}
+static void callMemoryBarrier(uintptr_t fn, GLbitfield p0) {
+	((void (*)(GLbitfield))fn)(p0);
+}
+static void callMemoryBarrierByRegion(uintptr_t fn, GLbitfield p0) {
+	((void (*)(GLbitfield))fn)(p0);
+}
+static void callMinSampleShading(uintptr_t fn, GLfloat p0) {
+	((void (*)(GLfloat))fn)(p0);
+}
+static void callObjectLabel(uintptr_t fn, GLenum p0, GLuint p1, GLsizei p2, void * p3) {
+	((void (*)(GLenum, GLuint, GLsizei, const GLchar *))fn)(p0, p1, p2, p3);
+}
+static void callObjectPtrLabel(uintptr_t fn, void * p0, GLsizei p1, void * p2) {
+	((void (*)(const void *, GLsizei, const GLchar *))fn)(p0, p1, p2);
+}
+static void callPatchParameteri(uintptr_t fn, GLenum p0, GLint p1) {
+	((void (*)(GLenum, GLint))fn)(p0, p1);
+}
+static void callPauseTransformFeedback(uintptr_t fn) {
+	((void (*)(void))fn)();
+}
+static void callPixelStorei(uintptr_t fn, GLenum p0, GLint p1) {
+	((void (*)(GLenum, GLint))fn)(p0, p1);
+}
+static void callPolygonOffset(uintptr_t fn, GLfloat p0, GLfloat p1) {
+	((void (*)(GLfloat, GLfloat))fn)(p0, p1);
+}
+static void callPopDebugGroup(uintptr_t fn) {
+	((void (*)(void))fn)();
+}
+static void callPrimitiveBoundingBox(uintptr_t fn, GLfloat p0, GLfloat p1, GLfloat p2, GLfloat p3, GLfloat p4, GLfloat p5, GLfloat p6, GLfloat p7) {
+	((void (*)(GLfloat, GLfloat, GLfloat, GLfloat, GLfloat, GLfloat, GLfloat, GLfloat))fn)(p0, p1, p2, p3, p4, p5, p6, p7);
+}
+static void callProgramBinary(uintptr_t fn, GLuint p0, GLenum p1, void * p2, GLsizei p3) {
+	((void (*)(GLuint, GLenum, const void *, GLsizei))fn)(p0, p1, p2, p3);
+}
+static void callProgramParameteri(uintptr_t fn, GLuint p0, GLenum p1, GLint p2) {
+	((void (*)(GLuint, GLenum, GLint))fn)(p0, p1, p2);
+}
+static void callProgramUniform1f(uintptr_t fn, GLuint p0, GLint p1, GLfloat p2) {
+	((void (*)(GLuint, GLint, GLfloat))fn)(p0, p1, p2);
+}
+static void callProgramUniform1fv(uintptr_t fn, GLuint p0, GLint p1, GLsizei p2, void * p3) {
+	((void (*)(GLuint, GLint, GLsizei, const GLfloat *))fn)(p0, p1, p2, p3);
+}
+static void callProgramUniform1i(uintptr_t fn, GLuint p0, GLint p1, GLint p2) {
+	((void (*)(GLuint, GLint, GLint))fn)(p0, p1, p2);
+}
+static void callProgramUniform1iv(uintptr_t fn, GLuint p0, GLint p1, GLsizei p2, void * p3) {
+	((void (*)(GLuint, GLint, GLsizei, const GLint *))fn)(p0, p1, p2, p3);
+}
+static void callProgramUniform1ui(uintptr_t fn, GLuint p0, GLint p1, GLuint p2) {
+	((void (*)(GLuint, GLint, GLuint))fn)(p0, p1, p2);
+}
+static void callProgramUniform1uiv(uintptr_t fn, GLuint p0, GLint p1, GLsizei p2, void * p3) {
+	((void (*)(GLuint, GLint, GLsizei, const GLuint *))fn)(p0, p1, p2, p3);
+}
+static void callProgramUniform2f(uintptr_t fn, GLuint p0, GLint p1, GLfloat p2, GLfloat p3) {
+	((void (*)(GLuint, GLint, GLfloat, GLfloat))fn)(p0, p1, p2, p3);
+}
+static void callProgramUniform2fv(uintptr_t fn, GLuint p0, GLint p1, GLsizei p2, void * p3) {
+	((void (*)(GLuint, GLint, GLsizei, const GLfloat *))fn)(p0, p1, p2, p3);
+}
+static void callProgramUniform2i(uintptr_t fn, GLuint p0, GLint p1, GLint p2, GLint p3) {
+	((void (*)(GLuint, GLint, GLint, GLint))fn)(p0, p1, p2, p3);
+}
+static void callProgramUniform2iv(uintptr_t fn, GLuint p0, GLint p1, GLsizei p2, void * p3) {
+	((void (*)(GLuint, GLint, GLsizei, const GLint *))fn)(p0, p1, p2, p3);
+}
+static void callProgramUniform2ui(uintptr_t fn, GLuint p0, GLint p1, GLuint p2, GLuint p3) {
+	((void (*)(GLuint, GLint, GLuint, GLuint))fn)(p0, p1, p2, p3);
+}
+static void callProgramUniform2uiv(uintptr_t fn, GLuint p0, GLint p1, GLsizei p2, void * p3) {
+	((void (*)(GLuint, GLint, GLsizei, const GLuint *))fn)(p0, p1, p2, p3);
+}
+static void callProgramUniform3f(uintptr_t fn, GLuint p0, GLint p1, GLfloat p2, GLfloat p3, GLfloat p4) {
+	((void (*)(GLuint, GLint, GLfloat, GLfloat, GLfloat))fn)(p0, p1, p2, p3, p4);
+}
+static void callProgramUniform3fv(uintptr_t fn, GLuint p0, GLint p1, GLsizei p2, void * p3) {
+	((void (*)(GLuint, GLint, GLsizei, const GLfloat *))fn)(p0, p1, p2, p3);
+}
+static void callProgramUniform3i(uintptr_t fn, GLuint p0, GLint p1, GLint p2, GLint p3, GLint p4) {
+	((void (*)(GLuint, GLint, GLint, GLint, GLint))fn)(p0, p1, p2, p3, p4);
+}
+static void callProgramUniform3iv(uintptr_t fn, GLuint p0, GLint p1, GLsizei p2, void * p3) {
+	((void (*)(GLuint, GLint, GLsizei, const GLint *))fn)(p0, p1, p2, p3);
+}
+static void callProgramUniform3ui(uintptr_t fn, GLuint p0, GLint p1, GLuint p2, GLuint p3, GLuint p4) {
+	((void (*)(GLuint, GLint, GLuint, GLuint, GLuint))fn)(p0, p1, p2, p3, p4);
+}
+static void callProgramUniform3uiv(uintptr_t fn, GLuint p0, GLint p1, GLsizei p2, void * p3) {
+	((void (*)(GLuint, GLint, GLsizei, const GLuint *))fn)(p0, p1, p2, p3);
+}
+static void callProgramUniform4f(uintptr_t fn, GLuint p0, GLint p1, GLfloat p2, GLfloat p3, GLfloat p4, GLfloat p5) {
+	((void (*)(GLuint, GLint, GLfloat, GLfloat, GLfloat, GLfloat))fn)(p0, p1, p2, p3, p4, p5);
+}
+static void callProgramUniform4fv(uintptr_t fn, GLuint p0, GLint p1, GLsizei p2, void * p3) {
+	((void (*)(GLuint, GLint, GLsizei, const GLfloat *))fn)(p0, p1, p2, p3);
+}
+static void callProgramUniform4i(uintptr_t fn, GLuint p0, GLint p1, GLint p2, GLint p3, GLint p4, GLint p5) {
+	((void (*)(GLuint, GLint, GLint, GLint, GLint, GLint))fn)(p0, p1, p2, p3, p4, p5);
+}
+static void callProgramUniform4iv(uintptr_t fn, GLuint p0, GLint p1, GLsizei p2, void * p3) {
+	((void (*)(GLuint, GLint, GLsizei, const GLint *))fn)(p0, p1, p2, p3);
+}
+static void callProgramUniform4ui(uintptr_t fn, GLuint p0, GLint p1, GLuint p2, GLuint p3, GLuint p4, GLuint p5) {
+	((void (*)(GLuint, GLint, GLuint, GLuint, GLuint, GLuint))fn)(p0, p1, p2, p3, p4, p5);
+}
+static void callProgramUniform4uiv(uintptr_t fn, GLuint p0, GLint p1, GLsizei p2, void * p3) {
+	((void (*)(GLuint, GLint, GLsizei, const GLuint *))fn)(p0, p1, p2, p3);
+}
+static void callProgramUniformMatrix2fv(uintptr_t fn, GLuint p0, GLint p1, GLsizei p2, GLboolean p3, void * p4) {
+	((void (*)(GLuint, GLint, GLsizei, GLboolean, const GLfloat *))fn)(p0, p1, p2, p3, p4);
+}
+static void callProgramUniformMatrix2x3fv(uintptr_t fn, GLuint p0, GLint p1, GLsizei p2, GLboolean p3, void * p4) {
+	((void (*)(GLuint, GLint, GLsizei, GLboolean, const GLfloat *))fn)(p0, p1, p2, p3, p4);
+}
+static void callProgramUniformMatrix2x4fv(uintptr_t fn, GLuint p0, GLint p1, GLsizei p2, GLboolean p3, void * p4) {
+	((void (*)(GLuint, GLint, GLsizei, GLboolean, const GLfloat *))fn)(p0, p1, p2, p3, p4);
+}
+static void callProgramUniformMatrix3fv(uintptr_t fn, GLuint p0, GLint p1, GLsizei p2, GLboolean p3, void * p4) {
+	((void (*)(GLuint, GLint, GLsizei, GLboolean, const GLfloat *))fn)(p0, p1, p2, p3, p4);
+}
+static void callProgramUniformMatrix3x2fv(uintptr_t fn, GLuint p0, GLint p1, GLsizei p2, GLboolean p3, void * p4) {
+	((void (*)(GLuint, GLint, GLsizei, GLboolean, const GLfloat *))fn)(p0, p1, p2, p3, p4);
+}
+static void callProgramUniformMatrix3x4fv(uintptr_t fn, GLuint p0, GLint p1, GLsizei p2, GLboolean p3, void * p4) {
+	((void (*)(GLuint, GLint, GLsizei, GLboolean, const GLfloat *))fn)(p0, p1, p2, p3, p4);
+}
+static void callProgramUniformMatrix4fv(uintptr_t fn, GLuint p0, GLint p1, GLsizei p2, GLboolean p3, void * p4) {
+	((void (*)(GLuint, GLint, GLsizei, GLboolean, const GLfloat *))fn)(p0, p1, p2, p3, p4);
+}
+static void callProgramUniformMatrix4x2fv(uintptr_t fn, GLuint p0, GLint p1, GLsizei p2, GLboolean p3, void * p4) {
+	((void (*)(GLuint, GLint, GLsizei, GLboolean, const GLfloat *))fn)(p0, p1, p2, p3, p4);
+}
+static void callProgramUniformMatrix4x3fv(uintptr_t fn, GLuint p0, GLint p1, GLsizei p2, GLboolean p3, void * p4) {
+	((void (*)(GLuint, GLint, GLsizei, GLboolean, const GLfloat *))fn)(p0, p1, p2, p3, p4);
+}
+static void callPushDebugGroup(uintptr_t fn, GLenum p0, GLuint p1, GLsizei p2, void * p3) {
+	((void (*)(GLenum, GLuint, GLsizei, const GLchar *))fn)(p0, p1, p2, p3);
+}
+static void callReadBuffer(uintptr_t fn, GLenum p0) {
+	((void (*)(GLenum))fn)(p0);
+}
+static void callReadPixels(uintptr_t fn, GLint p0, GLint p1, GLsizei p2, GLsizei p3, GLenum p4, GLenum p5, void * p6) {
+	((void (*)(GLint, GLint, GLsizei, GLsizei, GLenum, GLenum, void *))fn)(p0, p1, p2, p3, p4, p5, p6);
+}
+static void callReadnPixels(uintptr_t fn, GLint p0, GLint p1, GLsizei p2, GLsizei p3, GLenum p4, GLenum p5, GLsizei p6, void * p7) {
+	((void (*)(GLint, GLint, GLsizei, GLsizei, GLenum, GLenum, GLsizei, void *))fn)(p0, p1, p2, p3, p4, p5, p6, p7);
+}
+static void callReleaseShaderCompiler(uintptr_t fn) {
+	((void (*)(void))fn)();
+}
+static void callRenderbufferStorage(uintptr_t fn, GLenum p0, GLenum p1, GLsizei p2, GLsizei p3) {
+	((void (*)(GLenum, GLenum, GLsizei, GLsizei))fn)(p0, p1, p2, p3);
+}
+static void callRenderbufferStorageMultisample(uintptr_t fn, GLenum p0, GLsizei p1, GLenum p2, GLsizei p3, GLsizei p4) {
+	((void (*)(GLenum, GLsizei, GLenum, GLsizei, GLsizei))fn)(p0, p1, p2, p3, p4);
+}
+static void callResumeTransformFeedback(uintptr_t fn) {
+	((void (*)(void))fn)();
+}
+static void callSampleCoverage(uintptr_t fn, GLfloat p0, GLboolean p1) {
+	((void (*)(GLfloat, GLboolean))fn)(p0, p1);
+}
+static void callSampleMaski(uintptr_t fn, GLuint p0, GLbitfield p1) {
+	((void (*)(GLuint, GLbitfield))fn)(p0, p1);
+}
+static void callSamplerParameterIiv(uintptr_t fn, GLuint p0, GLenum p1, void * p2) {
+	((void (*)(GLuint, GLenum, const GLint *))fn)(p0, p1, p2);
+}
+static void callSamplerParameterIuiv(uintptr_t fn, GLuint p0, GLenum p1, void * p2) {
+	((void (*)(GLuint, GLenum, const GLuint *))fn)(p0, p1, p2);
+}
+static void callSamplerParameterf(uintptr_t fn, GLuint p0, GLenum p1, GLfloat p2) {
+	((void (*)(GLuint, GLenum, GLfloat))fn)(p0, p1, p2);
+}
+static void callSamplerParameterfv(uintptr_t fn, GLuint p0, GLenum p1, void * p2) {
+	((void (*)(GLuint, GLenum, const GLfloat *))fn)(p0, p1, p2);
+}
+static void callSamplerParameteri(uintptr_t fn, GLuint p0, GLenum p1, GLint p2) {
+	((void (*)(GLuint, GLenum, GLint))fn)(p0, p1, p2);
+}
+static void callSamplerParameteriv(uintptr_t fn, GLuint p0, GLenum p1, void * p2) {
+	((void (*)(GLuint, GLenum, const GLint *))fn)(p0, p1, p2);
+}
+static void callScissor(uintptr_t fn, GLint p0, GLint p1, GLsizei p2, GLsizei p3) {
+	((void (*)(GLint, GLint, GLsizei, GLsizei))fn)(p0, p1, p2, p3);
+}
+static void callShaderBinary(uintptr_t fn, GLsizei p0, void * p1, GLenum p2, void * p3, GLsizei p4) {
+	((void (*)(GLsizei, const GLuint *, GLenum, const void *, GLsizei))fn)(p0, p1, p2, p3, p4);
+}
+static void callShaderSource(uintptr_t fn, GLuint p0, GLsizei p1, void * p2, void * p3) {
+	((void (*)(GLuint, GLsizei, const GLchar *const*, const GLint *))fn)(p0, p1, p2, p3);
+}
+static void callStencilFunc(uintptr_t fn, GLenum p0, GLint p1, GLuint p2) {
+	((void (*)(GLenum, GLint, GLuint))fn)(p0, p1, p2);
+}
+static void callStencilFuncSeparate(uintptr_t fn, GLenum p0, GLenum p1, GLint p2, GLuint p3) {
+	((void (*)(GLenum, GLenum, GLint, GLuint))fn)(p0, p1, p2, p3);
+}
+static void callStencilMask(uintptr_t fn, GLuint p0) {
+	((void (*)(GLuint))fn)(p0);
+}
+static void callStencilMaskSeparate(uintptr_t fn, GLenum p0, GLuint p1) {
+	((void (*)(GLenum, GLuint))fn)(p0, p1);
+}
+static void callStencilOp(uintptr_t fn, GLenum p0, GLenum p1, GLenum p2) {
+	((void (*)(GLenum, GLenum, GLenum))fn)(p0, p1, p2);
+}
+static void callStencilOpSeparate(uintptr_t fn, GLenum p0, GLenum p1, GLenum p2, GLenum p3) {
+	((void (*)(GLenum, GLenum, GLenum, GLenum))fn)(p0, p1, p2, p3);
+}
+static void callTexBuffer(uintptr_t fn, GLenum p0, GLenum p1, GLuint p2) {
+	((void (*)(GLenum, GLenum, GLuint))fn)(p0, p1, p2);
+}
+static void callTexBufferRange(uintptr_t fn, GLenum p0, GLenum p1, GLuint p2, GLintptr p3, GLsizeiptr p4) {
+	((void (*)(GLenum, GLenum, GLuint, GLintptr, GLsizeiptr))fn)(p0, p1, p2, p3, p4);
+}
+static void callTexImage2D(uintptr_t fn, GLenum p0, GLint p1, GLint p2, GLsizei p3, GLsizei p4, GLint p5, GLenum p6, GLenum p7, void * p8) {
+	((void (*)(GLenum, GLint, GLint, GLsizei, GLsizei, GLint, GLenum, GLenum, const void *))fn)(p0, p1, p2, p3, p4, p5, p6, p7, p8);
+}
+static void callTexImage3D(uintptr_t fn, GLenum p0, GLint p1, GLint p2, GLsizei p3, GLsizei p4, GLsizei p5, GLint p6, GLenum p7, GLenum p8, void * p9) {
+	((void (*)(GLenum, GLint, GLint, GLsizei, GLsizei, GLsizei, GLint, GLenum, GLenum, const void *))fn)(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9);
+}
+static void callTexParameterIiv(uintptr_t fn, GLenum p0, GLenum p1, void * p2) {
+	((void (*)(GLenum, GLenum, const GLint *))fn)(p0, p1, p2);
+}
+static void callTexParameterIuiv(uintptr_t fn, GLenum p0, GLenum p1, void * p2) {
+	((void (*)(GLenum, GLenum, const GLuint *))fn)(p0, p1, p2);
+}
+static void callTexParameterf(uintptr_t fn, GLenum p0, GLenum p1, GLfloat p2) {
+	((void (*)(GLenum, GLenum, GLfloat))fn)(p0, p1, p2);
+}
+static void callTexParameterfv(uintptr_t fn, GLenum p0, GLenum p1, void * p2) {
+	((void (*)(GLenum, GLenum, const GLfloat *))fn)(p0, p1, p2);
+}
+static void callTexParameteri(uintptr_t fn, GLenum p0, GLenum p1, GLint p2) {
+	((void (*)(GLenum, GLenum, GLint))fn)(p0, p1, p2);
+}
+static void callTexParameteriv(uintptr_t fn, GLenum p0, GLenum p1, void * p2) {
+	((void (*)(GLenum, GLenum, const GLint *))fn)(p0, p1, p2);
+}
+static void callTexStorage2D(uintptr_t fn, GLenum p0, GLsizei p1, GLenum p2, GLsizei p3, GLsizei p4) {
+	((void (*)(GLenum, GLsizei, GLenum, GLsizei, GLsizei))fn)(p0, p1, p2, p3, p4);
+}
+static void callTexStorage2DMultisample(uintptr_t fn, GLenum p0, GLsizei p1, GLenum p2, GLsizei p3, GLsizei p4, GLboolean p5) {
+	((void (*)(GLenum, GLsizei, GLenum, GLsizei, GLsizei, GLboolean))fn)(p0, p1, p2, p3, p4, p5);
+}
+static void callTexStorage3D(uintptr_t fn, GLenum p0, GLsizei p1, GLenum p2, GLsizei p3, GLsizei p4, GLsizei p5) {
+	((void (*)(GLenum, GLsizei, GLenum, GLsizei, GLsizei, GLsizei))fn)(p0, p1, p2, p3, p4, p5);
+}
+static void callTexStorage3DMultisample(uintptr_t fn, GLenum p0, GLsizei p1, GLenum p2, GLsizei p3, GLsizei p4, GLsizei p5, GLboolean p6) {
+	((void (*)(GLenum, GLsizei, GLenum, GLsizei, GLsizei, GLsizei, GLboolean))fn)(p0, p1, p2, p3, p4, p5, p6);
+}
+static void callTexSubImage2D(uintptr_t fn, GLenum p0, GLint p1, GLint p2, GLint p3, GLsizei p4, GLsizei p5, GLenum p6, GLenum p7, void * p8) {
+	((void (*)(GLenum, GLint, GLint, GLint, GLsizei, GLsizei, GLenum, GLenum, const void *))fn)(p0, p1, p2, p3, p4, p5, p6, p7, p8);
+}
+static void callTexSubImage3D(uintptr_t fn, GLenum p0, GLint p1, GLint p2, GLint p3, GLint p4, GLsizei p5, GLsizei p6, GLsizei p7, GLenum p8, GLenum p9, void * p10) {
+	((void (*)(GLenum, GLint, GLint, GLint, GLint, GLsizei, GLsizei, GLsizei, GLenum, GLenum, const void *))fn)(p0, p1, p2, p3, p4, p5, p6, p7, p8, p9, p10);
+}
+static void callTransformFeedbackVaryings(uintptr_t fn, GLuint p0, GLsizei p1, void * p2, GLenum p3) {
+	((void (*)(GLuint, GLsizei, const GLchar *const*, GLenum))fn)(p0, p1, p2, p3);
+}
+static void callUniform1f(uintptr_t fn, GLint p0, GLfloat p1) {
+	((void (*)(GLint, GLfloat))fn)(p0, p1);
+}
+static void callUniform1fv(uintptr_t fn, GLint p0, GLsizei p1, void * p2) {
+	((void (*)(GLint, GLsizei, const GLfloat *))fn)(p0, p1, p2);
+}
+static void callUniform1i(uintptr_t fn, GLint p0, GLint p1) {
+	((void (*)(GLint, GLint))fn)(p0, p1);
+}
+static void callUniform1iv(uintptr_t fn, GLint p0, GLsizei p1, void * p2) {
+	((void (*)(GLint, GLsizei, const GLint *))fn)(p0, p1, p2);
+}
+static void callUniform1ui(uintptr_t fn, GLint p0, GLuint p1) {
+	((void (*)(GLint, GLuint))fn)(p0, p1);
+}
+static void callUniform1uiv(uintptr_t fn, GLint p0, GLsizei p1, void * p2) {
+	((void (*)(GLint, GLsizei, const GLuint *))fn)(p0, p1, p2);
+}
+static void callUniform2f(uintptr_t fn, GLint p0, GLfloat p1, GLfloat p2) {
+	((void (*)(GLint, GLfloat, GLfloat))fn)(p0, p1, p2);
+}
+static void callUniform2fv(uintptr_t fn, GLint p0, GLsizei p1, void * p2) {
+	((void (*)(GLint, GLsizei, const GLfloat *))fn)(p0, p1, p2);
+}
+static void callUniform2i(uintptr_t fn, GLint p0, GLint p1, GLint p2) {
+	((void (*)(GLint, GLint, GLint))fn)(p0, p1, p2);
+}
+static void callUniform2iv(uintptr_t fn, GLint p0, GLsizei p1, void * p2) {
+	((void (*)(GLint, GLsizei, const GLint *))fn)(p0, p1, p2);
+}
+static void callUniform2ui(uintptr_t fn, GLint p0, GLuint p1, GLuint p2) {
+	((void (*)(GLint, GLuint, GLuint))fn)(p0, p1, p2);
+}
+static void callUniform2uiv(uintptr_t fn, GLint p0, GLsizei p1, void * p2) {
+	((void (*)(GLint, GLsizei, const GLuint *))fn)(p0, p1, p2);
+}
+static void callUniform3f(uintptr_t fn, GLint p0, GLfloat p1, GLfloat p2, GLfloat p3) {
+	((void (*)(GLint, GLfloat, GLfloat, GLfloat))fn)(p0, p1, p2, p3);
+}
+static void callUniform3fv(uintptr_t fn, GLint p0, GLsizei p1, void * p2) {
+	((void (*)(GLint, GLsizei, const GLfloat *))fn)(p0, p1, p2);
+}
+static void callUniform3i(uintptr_t fn, GLint p0, GLint p1, GLint p2, GLint p3) {
+	((void (*)(GLint, GLint, GLint, GLint))fn)(p0, p1, p2, p3);
+}
+static void callUniform3iv(uintptr_t fn, GLint p0, GLsizei p1, void * p2) {
+	((void (*)(GLint, GLsizei, const GLint *))fn)(p0, p1, p2);
+}
+static void callUniform3ui(uintptr_t fn, GLint p0, GLuint p1, GLuint p2, GLuint p3) {
+	((void (*)(GLint, GLuint, GLuint, GLuint))fn)(p0, p1, p2, p3);
+}
+static void callUniform3uiv(uintptr_t fn, GLint p0, GLsizei p1, void * p2) {
+	((void (*)(GLint, GLsizei, const GLuint *))fn)(p0, p1, p2);
+}
+static void callUniform4f(uintptr_t fn, GLint p0, GLfloat p1, GLfloat p2, GLfloat p3, GLfloat p4) {
+	((void (*)(GLint, GLfloat, GLfloat, GLfloat, GLfloat))fn)(p0, p1, p2, p3, p4);
+}
+static void callUniform4fv(uintptr_t fn, GLint p0, GLsizei p1, void * p2) {
+	((void (*)(GLint, GLsizei, const GLfloat *))fn)(p0, p1, p2);
+}
+static void callUniform4i(uintptr_t fn, GLint p0, GLint p1, GLint p2, GLint p3, GLint p4) {
+	((void (*)(GLint, GLint, GLint, GLint, GLint))fn)(p0, p1, p2, p3, p4);
+}
+static void callUniform4iv(uintptr_t fn, GLint p0, GLsizei p1, void * p2) {
+	((void (*)(GLint, GLsizei, const GLint *))fn)(p0, p1, p2);
+}
+static void callUniform4ui(uintptr_t fn, GLint p0, GLuint p1, GLuint p2, GLuint p3, GLuint p4) {
+	((void (*)(GLint, GLuint, GLuint, GLuint, GLuint))fn)(p0, p1, p2, p3, p4);
+}
+static void callUniform4uiv(uintptr_t fn, GLint p0, GLsizei p1, void * p2) {
+	((void (*)(GLint, GLsizei, const GLuint *))fn)(p0, p1, p2);
+}
+static void callUniformBlockBinding(uintptr_t fn, GLuint p0, GLuint p1, GLuint p2) {
+	((void (*)(GLuint, GLuint, GLuint))fn)(p0, p1, p2);
+}
+static void callUniformMatrix2fv(uintptr_t fn, GLint p0, GLsizei p1, GLboolean p2, void * p3) {
+	((void (*)(GLint, GLsizei, GLboolean, const GLfloat *))fn)(p0, p1, p2, p3);
+}
+static void callUniformMatrix2x3fv(uintptr_t fn, GLint p0, GLsizei p1, GLboolean p2, void * p3) {
+	((void (*)(GLint, GLsizei, GLboolean, const GLfloat *))fn)(p0, p1, p2, p3);
+}
+static void callUniformMatrix2x4fv(uintptr_t fn, GLint p0, GLsizei p1, GLboolean p2, void * p3) {
+	((void (*)(GLint, GLsizei, GLboolean, const GLfloat *))fn)(p0, p1, p2, p3);
+}
+static void callUniformMatrix3fv(uintptr_t fn, GLint p0, GLsizei p1, GLboolean p2, void * p3) {
+	((void (*)(GLint, GLsizei, GLboolean, const GLfloat *))fn)(p0, p1, p2, p3);
+}
+static void callUniformMatrix3x2fv(uintptr_t fn, GLint p0, GLsizei p1, GLboolean p2, void * p3) {
+	((void (*)(GLint, GLsizei, GLboolean, const GLfloat *))fn)(p0, p1, p2, p3);
+}
+static void callUniformMatrix3x4fv(uintptr_t fn, GLint p0, GLsizei p1, GLboolean p2, void * p3) {
+	((void (*)(GLint, GLsizei, GLboolean, const GLfloat *))fn)(p0, p1, p2, p3);
+}
+static void callUniformMatrix4fv(uintptr_t fn, GLint p0, GLsizei p1, GLboolean p2, void * p3) {
+	((void (*)(GLint, GLsizei, GLboolean, const GLfloat *))fn)(p0, p1, p2, p3);
+}
+static void callUniformMatrix4x2fv(uintptr_t fn, GLint p0, GLsizei p1, GLboolean p2, void * p3) {
+	((void (*)(GLint, GLsizei, GLboolean, const GLfloat *))fn)(p0, p1, p2, p3);
+}
+static void callUniformMatrix4x3fv(uintptr_t fn, GLint p0, GLsizei p1, GLboolean p2, void * p3) {
+	((void (*)(GLint, GLsizei, GLboolean, const GLfloat *))fn)(p0, p1, p2, p3);
+}
+static GLboolean callUnmapBuffer(uintptr_t fn, GLenum p0) {
+	return ((GLboolean (*)(GLenum))fn)(p0);
+}
+static void callUseProgram(uintptr_t fn, GLuint p0) {
+	((void (*)(GLuint))fn)(p0);
+}
+static void callUseProgramStages(uintptr_t fn, GLuint p0, GLbitfield p1, GLuint p2) {
+	((void (*)(GLuint, GLbitfield, GLuint))fn)(p0, p1, p2);
+}
+static void callValidateProgram(uintptr_t fn, GLuint p0) {
+	((void (*)(GLuint))fn)(p0);
+}
+static void callValidateProgramPipeline(uintptr_t fn, GLuint p0) {
+	((void (*)(GLuint))fn)(p0);
+}
+static void callVertexAttrib1f(uintptr_t fn, GLuint p0, GLfloat p1) {
+	((void (*)(GLuint, GLfloat))fn)(p0, p1);
+}
+static void callVertexAttrib1fv(uintptr_t fn, GLuint p0, void * p1) {
+	((void (*)(GLuint, const GLfloat *))fn)(p0, p1);
+}
+static void callVertexAttrib2f(uintptr_t fn, GLuint p0, GLfloat p1, GLfloat p2) {
+	((void (*)(GLuint, GLfloat, GLfloat))fn)(p0, p1, p2);
+}
+static void callVertexAttrib2fv(uintptr_t fn, GLuint p0, void * p1) {
+	((void (*)(GLuint, const GLfloat *))fn)(p0, p1);
+}
+static void callVertexAttrib3f(uintptr_t fn, GLuint p0, GLfloat p1, GLfloat p2, GLfloat p3) {
+	((void (*)(GLuint, GLfloat, GLfloat, GLfloat))fn)(p0, p1, p2, p3);
+}
+static void callVertexAttrib3fv(uintptr_t fn, GLuint p0, void * p1) {
+	((void (*)(GLuint, const GLfloat *))fn)(p0, p1);
+}
+static void callVertexAttrib4f(uintptr_t fn, GLuint p0, GLfloat p1, GLfloat p2, GLfloat p3, GLfloat p4) {
+	((void (*)(GLuint, GLfloat, GLfloat, GLfloat, GLfloat))fn)(p0, p1, p2, p3, p4);
+}
+static void callVertexAttrib4fv(uintptr_t fn, GLuint p0, void * p1) {
+	((void (*)(GLuint, const GLfloat *))fn)(p0, p1);
+}
+static void callVertexAttribBinding(uintptr_t fn, GLuint p0, GLuint p1) {
+	((void (*)(GLuint, GLuint))fn)(p0, p1);
+}
+static void callVertexAttribDivisor(uintptr_t fn, GLuint p0, GLuint p1) {
+	((void (*)(GLuint, GLuint))fn)(p0, p1);
+}
+static void callVertexAttribFormat(uintptr_t fn, GLuint p0, GLint p1, GLenum p2, GLboolean p3, GLuint p4) {
+	((void (*)(GLuint, GLint, GLenum, GLboolean, GLuint))fn)(p0, p1, p2, p3, p4);
+}
+static void callVertexAttribI4i(uintptr_t fn, GLuint p0, GLint p1, GLint p2, GLint p3, GLint p4) {
+	((void (*)(GLuint, GLint, GLint, GLint, GLint))fn)(p0, p1, p2, p3, p4);
+}
+static void callVertexAttribI4iv(uintptr_t fn, GLuint p0, void * p1) {
+	((void (*)(GLuint, const GLint *))fn)(p0, p1);
+}
+static void callVertexAttribI4ui(uintptr_t fn, GLuint p0, GLuint p1, GLuint p2, GLuint p3, GLuint p4) {
+	((void (*)(GLuint, GLuint, GLuint, GLuint, GLuint))fn)(p0, p1, p2, p3, p4);
+}
+static void callVertexAttribI4uiv(uintptr_t fn, GLuint p0, void * p1) {
+	((void (*)(GLuint, const GLuint *))fn)(p0, p1);
+}
+static void callVertexAttribIFormat(uintptr_t fn, GLuint p0, GLint p1, GLenum p2, GLuint p3) {
+	((void (*)(GLuint, GLint, GLenum, GLuint))fn)(p0, p1, p2, p3);
+}
+static void callVertexAttribIPointer(uintptr_t fn, GLuint p0, GLint p1, GLenum p2, GLsizei p3, void * p4) {
+	((void (*)(GLuint, GLint, GLenum, GLsizei, const void *))fn)(p0, p1, p2, p3, p4);
+}
+static void callVertexAttribPointer(uintptr_t fn, GLuint p0, GLint p1, GLenum p2, GLboolean p3, GLsizei p4, void * p5) {
+	((void (*)(GLuint, GLint, GLenum, GLboolean, GLsizei, const void *))fn)(p0, p1, p2, p3, p4, p5);
+}
+static void callVertexBindingDivisor(uintptr_t fn, GLuint p0, GLuint p1) {
+	((void (*)(GLuint, GLuint))fn)(p0, p1);
+}
+static void callViewport(uintptr_t fn, GLint p0, GLint p1, GLsizei p2, GLsizei p3) {
+	((void (*)(GLint, GLint, GLsizei, GLsizei))fn)(p0, p1, p2, p3);
+}
+static void callWaitSync(uintptr_t fn, uintptr_t p0, GLbitfield p1, GLuint64 p2) {
+	((void (*)(GLsync, GLbitfield, GLuint64))fn)((GLsync)p0, p1, p2);
+}
+*/
+import "C"
+
+import "unsafe"
+
+func cbool(b bool) C.GLboolean {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// ActiveShaderProgram calls glActiveShaderProgram (GL_ES_VERSION_3_1).
+func ActiveShaderProgram(pipeline uint32, program uint32) {
+	C.callActiveShaderProgram(C.uintptr_t(proc(procActiveShaderProgram)), C.GLuint(pipeline), C.GLuint(program))
+}
+
+// ActiveTexture calls glActiveTexture (GL_ES_VERSION_2_0).
+func ActiveTexture(texture Enum) {
+	C.callActiveTexture(C.uintptr_t(proc(procActiveTexture)), C.GLenum(texture))
+}
+
+// AttachShader calls glAttachShader (GL_ES_VERSION_2_0).
+func AttachShader(program uint32, shader uint32) {
+	C.callAttachShader(C.uintptr_t(proc(procAttachShader)), C.GLuint(program), C.GLuint(shader))
+}
+
+// BeginQuery calls glBeginQuery (GL_ES_VERSION_3_0).
+func BeginQuery(target Enum, id uint32) {
+	C.callBeginQuery(C.uintptr_t(proc(procBeginQuery)), C.GLenum(target), C.GLuint(id))
+}
+
+// BeginTransformFeedback calls glBeginTransformFeedback (GL_ES_VERSION_3_0).
+func BeginTransformFeedback(primitiveMode Enum) {
+	C.callBeginTransformFeedback(C.uintptr_t(proc(procBeginTransformFeedback)), C.GLenum(primitiveMode))
+}
+
+// BindAttribLocation calls glBindAttribLocation (GL_ES_VERSION_2_0).
+func BindAttribLocation(program uint32, index uint32, name string) {
+	cs2 := C.CString(name)
+	defer C.free(unsafe.Pointer(cs2))
+	C.callBindAttribLocation(C.uintptr_t(proc(procBindAttribLocation)), C.GLuint(program), C.GLuint(index), unsafe.Pointer(cs2))
+}
+
+// BindBuffer calls glBindBuffer (GL_ES_VERSION_2_0).
+func BindBuffer(target Enum, buffer uint32) {
+	C.callBindBuffer(C.uintptr_t(proc(procBindBuffer)), C.GLenum(target), C.GLuint(buffer))
+}
+
+// BindBufferBase calls glBindBufferBase (GL_ES_VERSION_3_0).
+func BindBufferBase(target Enum, index uint32, buffer uint32) {
+	C.callBindBufferBase(C.uintptr_t(proc(procBindBufferBase)), C.GLenum(target), C.GLuint(index), C.GLuint(buffer))
+}
+
+// BindBufferRange calls glBindBufferRange (GL_ES_VERSION_3_0).
+func BindBufferRange(target Enum, index uint32, buffer uint32, offset int, size int) {
+	C.callBindBufferRange(C.uintptr_t(proc(procBindBufferRange)), C.GLenum(target), C.GLuint(index), C.GLuint(buffer), C.GLintptr(offset), C.GLsizeiptr(size))
+}
+
+// BindFramebuffer calls glBindFramebuffer (GL_ES_VERSION_2_0).
+func BindFramebuffer(target Enum, framebuffer uint32) {
+	C.callBindFramebuffer(C.uintptr_t(proc(procBindFramebuffer)), C.GLenum(target), C.GLuint(framebuffer))
+}
+
+// BindImageTexture calls glBindImageTexture (GL_ES_VERSION_3_1).
+func BindImageTexture(unit uint32, texture uint32, level int32, layered bool, layer int32, access Enum, format Enum) {
+	C.callBindImageTexture(C.uintptr_t(proc(procBindImageTexture)), C.GLuint(unit), C.GLuint(texture), C.GLint(level), cbool(layered), C.GLint(layer), C.GLenum(access), C.GLenum(format))
+}
+
+// BindProgramPipeline calls glBindProgramPipeline (GL_ES_VERSION_3_1).
+func BindProgramPipeline(pipeline uint32) {
+	C.callBindProgramPipeline(C.uintptr_t(proc(procBindProgramPipeline)), C.GLuint(pipeline))
+}
+
+// BindRenderbuffer calls glBindRenderbuffer (GL_ES_VERSION_2_0).
+func BindRenderbuffer(target Enum, renderbuffer uint32) {
+	C.callBindRenderbuffer(C.uintptr_t(proc(procBindRenderbuffer)), C.GLenum(target), C.GLuint(renderbuffer))
+}
+
+// BindSampler calls glBindSampler (GL_ES_VERSION_3_0).
+func BindSampler(unit uint32, sampler uint32) {
+	C.callBindSampler(C.uintptr_t(proc(procBindSampler)), C.GLuint(unit), C.GLuint(sampler))
+}
+
+// BindTexture calls glBindTexture (GL_ES_VERSION_2_0).
+func BindTexture(target Enum, texture uint32) {
+	C.callBindTexture(C.uintptr_t(proc(procBindTexture)), C.GLenum(target), C.GLuint(texture))
+}
+
+// BindTransformFeedback calls glBindTransformFeedback (GL_ES_VERSION_3_0).
+func BindTransformFeedback(target Enum, id uint32) {
+	C.callBindTransformFeedback(C.uintptr_t(proc(procBindTransformFeedback)), C.GLenum(target), C.GLuint(id))
+}
+
+// BindVertexArray calls glBindVertexArray (GL_ES_VERSION_3_0).
+func BindVertexArray(array uint32) {
+	C.callBindVertexArray(C.uintptr_t(proc(procBindVertexArray)), C.GLuint(array))
+}
+
+// BindVertexBuffer calls glBindVertexBuffer (GL_ES_VERSION_3_1).
+func BindVertexBuffer(bindingindex uint32, buffer uint32, offset int, stride int32) {
+	C.callBindVertexBuffer(C.uintptr_t(proc(procBindVertexBuffer)), C.GLuint(bindingindex), C.GLuint(buffer), C.GLintptr(offset), C.GLsizei(stride))
+}
+
+// BlendBarrier calls glBlendBarrier (GL_ES_VERSION_3_2).
+func BlendBarrier() {
+	C.callBlendBarrier(C.uintptr_t(proc(procBlendBarrier)))
+}
+
+// BlendColor calls glBlendColor (GL_ES_VERSION_2_0).
+func BlendColor(red float32, green float32, blue float32, alpha float32) {
+	C.callBlendColor(C.uintptr_t(proc(procBlendColor)), C.GLfloat(red), C.GLfloat(green), C.GLfloat(blue), C.GLfloat(alpha))
+}
+
+// BlendEquation calls glBlendEquation (GL_ES_VERSION_2_0).
+func BlendEquation(mode Enum) {
+	C.callBlendEquation(C.uintptr_t(proc(procBlendEquation)), C.GLenum(mode))
+}
+
+// BlendEquationSeparate calls glBlendEquationSeparate (GL_ES_VERSION_2_0).
+func BlendEquationSeparate(modeRGB Enum, modeAlpha Enum) {
+	C.callBlendEquationSeparate(C.uintptr_t(proc(procBlendEquationSeparate)), C.GLenum(modeRGB), C.GLenum(modeAlpha))
+}
+
+// BlendEquationSeparatei calls glBlendEquationSeparatei (GL_ES_VERSION_3_2).
+func BlendEquationSeparatei(buf uint32, modeRGB Enum, modeAlpha Enum) {
+	C.callBlendEquationSeparatei(C.uintptr_t(proc(procBlendEquationSeparatei)), C.GLuint(buf), C.GLenum(modeRGB), C.GLenum(modeAlpha))
+}
+
+// BlendEquationi calls glBlendEquationi (GL_ES_VERSION_3_2).
+func BlendEquationi(buf uint32, mode Enum) {
+	C.callBlendEquationi(C.uintptr_t(proc(procBlendEquationi)), C.GLuint(buf), C.GLenum(mode))
+}
+
+// BlendFunc calls glBlendFunc (GL_ES_VERSION_2_0).
+func BlendFunc(sfactor Enum, dfactor Enum) {
+	C.callBlendFunc(C.uintptr_t(proc(procBlendFunc)), C.GLenum(sfactor), C.GLenum(dfactor))
+}
+
+// BlendFuncSeparate calls glBlendFuncSeparate (GL_ES_VERSION_2_0).
+func BlendFuncSeparate(sfactorRGB Enum, dfactorRGB Enum, sfactorAlpha Enum, dfactorAlpha Enum) {
+	C.callBlendFuncSeparate(C.uintptr_t(proc(procBlendFuncSeparate)), C.GLenum(sfactorRGB), C.GLenum(dfactorRGB), C.GLenum(sfactorAlpha), C.GLenum(dfactorAlpha))
+}
+
+// BlendFuncSeparatei calls glBlendFuncSeparatei (GL_ES_VERSION_3_2).
+func BlendFuncSeparatei(buf uint32, srcRGB Enum, dstRGB Enum, srcAlpha Enum, dstAlpha Enum) {
+	C.callBlendFuncSeparatei(C.uintptr_t(proc(procBlendFuncSeparatei)), C.GLuint(buf), C.GLenum(srcRGB), C.GLenum(dstRGB), C.GLenum(srcAlpha), C.GLenum(dstAlpha))
+}
+
+// BlendFunci calls glBlendFunci (GL_ES_VERSION_3_2).
+func BlendFunci(buf uint32, src Enum, dst Enum) {
+	C.callBlendFunci(C.uintptr_t(proc(procBlendFunci)), C.GLuint(buf), C.GLenum(src), C.GLenum(dst))
+}
+
+// BlitFramebuffer calls glBlitFramebuffer (GL_ES_VERSION_3_0).
+func BlitFramebuffer(srcX0 int32, srcY0 int32, srcX1 int32, srcY1 int32, dstX0 int32, dstY0 int32, dstX1 int32, dstY1 int32, mask uint32, filter Enum) {
+	C.callBlitFramebuffer(C.uintptr_t(proc(procBlitFramebuffer)), C.GLint(srcX0), C.GLint(srcY0), C.GLint(srcX1), C.GLint(srcY1), C.GLint(dstX0), C.GLint(dstY0), C.GLint(dstX1), C.GLint(dstY1), C.GLbitfield(mask), C.GLenum(filter))
+}
+
+// BufferData calls glBufferData (GL_ES_VERSION_2_0).
+func BufferData(target Enum, size int, data unsafe.Pointer, usage Enum) {
+	C.callBufferData(C.uintptr_t(proc(procBufferData)), C.GLenum(target), C.GLsizeiptr(size), data, C.GLenum(usage))
+}
+
+// BufferSubData calls glBufferSubData (GL_ES_VERSION_2_0).
+func BufferSubData(target Enum, offset int, size int, data unsafe.Pointer) {
+	C.callBufferSubData(C.uintptr_t(proc(procBufferSubData)), C.GLenum(target), C.GLintptr(offset), C.GLsizeiptr(size), data)
+}
+
+// CheckFramebufferStatus calls glCheckFramebufferStatus (GL_ES_VERSION_2_0).
+func CheckFramebufferStatus(target Enum) Enum {
+	return Enum(C.callCheckFramebufferStatus(C.uintptr_t(proc(procCheckFramebufferStatus)), C.GLenum(target)))
+}
+
+// Clear calls glClear (GL_ES_VERSION_2_0).
+func Clear(mask uint32) {
+	C.callClear(C.uintptr_t(proc(procClear)), C.GLbitfield(mask))
+}
+
+// ClearBufferfi calls glClearBufferfi (GL_ES_VERSION_3_0).
+func ClearBufferfi(buffer Enum, drawbuffer int32, depth float32, stencil int32) {
+	C.callClearBufferfi(C.uintptr_t(proc(procClearBufferfi)), C.GLenum(buffer), C.GLint(drawbuffer), C.GLfloat(depth), C.GLint(stencil))
+}
+
+// ClearBufferfv calls glClearBufferfv (GL_ES_VERSION_3_0).
+func ClearBufferfv(buffer Enum, drawbuffer int32, value *float32) {
+	C.callClearBufferfv(C.uintptr_t(proc(procClearBufferfv)), C.GLenum(buffer), C.GLint(drawbuffer), unsafe.Pointer(value))
+}
+
+// ClearBufferiv calls glClearBufferiv (GL_ES_VERSION_3_0).
+func ClearBufferiv(buffer Enum, drawbuffer int32, value *int32) {
+	C.callClearBufferiv(C.uintptr_t(proc(procClearBufferiv)), C.GLenum(buffer), C.GLint(drawbuffer), unsafe.Pointer(value))
+}
+
+// ClearBufferuiv calls glClearBufferuiv (GL_ES_VERSION_3_0).
+func ClearBufferuiv(buffer Enum, drawbuffer int32, value *uint32) {
+	C.callClearBufferuiv(C.uintptr_t(proc(procClearBufferuiv)), C.GLenum(buffer), C.GLint(drawbuffer), unsafe.Pointer(value))
+}
+
+// ClearColor calls glClearColor (GL_ES_VERSION_2_0).
+func ClearColor(red float32, green float32, blue float32, alpha float32) {
+	C.callClearColor(C.uintptr_t(proc(procClearColor)), C.GLfloat(red), C.GLfloat(green), C.GLfloat(blue), C.GLfloat(alpha))
+}
+
+// ClearDepthf calls glClearDepthf (GL_ES_VERSION_2_0).
+func ClearDepthf(d float32) {
+	C.callClearDepthf(C.uintptr_t(proc(procClearDepthf)), C.GLfloat(d))
+}
+
+// ClearStencil calls glClearStencil (GL_ES_VERSION_2_0).
+func ClearStencil(s int32) {
+	C.callClearStencil(C.uintptr_t(proc(procClearStencil)), C.GLint(s))
+}
+
+// ClientWaitSync calls glClientWaitSync (GL_ES_VERSION_3_0).
+func ClientWaitSync(sync uintptr, flags uint32, timeout uint64) Enum {
+	return Enum(C.callClientWaitSync(C.uintptr_t(proc(procClientWaitSync)), C.uintptr_t(sync), C.GLbitfield(flags), C.GLuint64(timeout)))
+}
+
+// ColorMask calls glColorMask (GL_ES_VERSION_2_0).
+func ColorMask(red bool, green bool, blue bool, alpha bool) {
+	C.callColorMask(C.uintptr_t(proc(procColorMask)), cbool(red), cbool(green), cbool(blue), cbool(alpha))
+}
+
+// ColorMaski calls glColorMaski (GL_ES_VERSION_3_2).
+func ColorMaski(index uint32, r bool, g bool, b bool, a bool) {
+	C.callColorMaski(C.uintptr_t(proc(procColorMaski)), C.GLuint(index), cbool(r), cbool(g), cbool(b), cbool(a))
+}
+
+// CompileShader calls glCompileShader (GL_ES_VERSION_2_0).
+func CompileShader(shader uint32) {
+	C.callCompileShader(C.uintptr_t(proc(procCompileShader)), C.GLuint(shader))
+}
+
+// CompressedTexImage2D calls glCompressedTexImage2D (GL_ES_VERSION_2_0).
+func CompressedTexImage2D(target Enum, level int32, internalformat Enum, width int32, height int32, border int32, imageSize int32, data unsafe.Pointer) {
+	C.callCompressedTexImage2D(C.uintptr_t(proc(procCompressedTexImage2D)), C.GLenum(target), C.GLint(level), C.GLenum(internalformat), C.GLsizei(width), C.GLsizei(height), C.GLint(border), C.GLsizei(imageSize), data)
+}
+
+// CompressedTexImage3D calls glCompressedTexImage3D (GL_ES_VERSION_3_0).
+func CompressedTexImage3D(target Enum, level int32, internalformat Enum, width int32, height int32, depth int32, border int32, imageSize int32, data unsafe.Pointer) {
+	C.callCompressedTexImage3D(C.uintptr_t(proc(procCompressedTexImage3D)), C.GLenum(target), C.GLint(level), C.GLenum(internalformat), C.GLsizei(width), C.GLsizei(height), C.GLsizei(depth), C.GLint(border), C.GLsizei(imageSize), data)
+}
+
+// CompressedTexSubImage2D calls glCompressedTexSubImage2D (GL_ES_VERSION_2_0).
+func CompressedTexSubImage2D(target Enum, level int32, xoffset int32, yoffset int32, width int32, height int32, format Enum, imageSize int32, data unsafe.Pointer) {
+	C.callCompressedTexSubImage2D(C.uintptr_t(proc(procCompressedTexSubImage2D)), C.GLenum(target), C.GLint(level), C.GLint(xoffset), C.GLint(yoffset), C.GLsizei(width), C.GLsizei(height), C.GLenum(format), C.GLsizei(imageSize), data)
+}
+
+// CompressedTexSubImage3D calls glCompressedTexSubImage3D (GL_ES_VERSION_3_0).
+func CompressedTexSubImage3D(target Enum, level int32, xoffset int32, yoffset int32, zoffset int32, width int32, height int32, depth int32, format Enum, imageSize int32, data unsafe.Pointer) {
+	C.callCompressedTexSubImage3D(C.uintptr_t(proc(procCompressedTexSubImage3D)), C.GLenum(target), C.GLint(level), C.GLint(xoffset), C.GLint(yoffset), C.GLint(zoffset), C.GLsizei(width), C.GLsizei(height), C.GLsizei(depth), C.GLenum(format), C.GLsizei(imageSize), data)
+}
+
+// CopyBufferSubData calls glCopyBufferSubData (GL_ES_VERSION_3_0).
+func CopyBufferSubData(readTarget Enum, writeTarget Enum, readOffset int, writeOffset int, size int) {
+	C.callCopyBufferSubData(C.uintptr_t(proc(procCopyBufferSubData)), C.GLenum(readTarget), C.GLenum(writeTarget), C.GLintptr(readOffset), C.GLintptr(writeOffset), C.GLsizeiptr(size))
+}
+
+// CopyImageSubData calls glCopyImageSubData (GL_ES_VERSION_3_2).
+func CopyImageSubData(srcName uint32, srcTarget Enum, srcLevel int32, srcX int32, srcY int32, srcZ int32, dstName uint32, dstTarget Enum, dstLevel int32, dstX int32, dstY int32, dstZ int32, srcWidth int32, srcHeight int32, srcDepth int32) {
+	C.callCopyImageSubData(C.uintptr_t(proc(procCopyImageSubData)), C.GLuint(srcName), C.GLenum(srcTarget), C.GLint(srcLevel), C.GLint(srcX), C.GLint(srcY), C.GLint(srcZ), C.GLuint(dstName), C.GLenum(dstTarget), C.GLint(dstLevel), C.GLint(dstX), C.GLint(dstY), C.GLint(dstZ), C.GLsizei(srcWidth), C.GLsizei(srcHeight), C.GLsizei(srcDepth))
+}
+
+// CopyTexImage2D calls glCopyTexImage2D (GL_ES_VERSION_2_0).
+func CopyTexImage2D(target Enum, level int32, internalformat Enum, x int32, y int32, width int32, height int32, border int32) {
+	C.callCopyTexImage2D(C.uintptr_t(proc(procCopyTexImage2D)), C.GLenum(target), C.GLint(level), C.GLenum(internalformat), C.GLint(x), C.GLint(y), C.GLsizei(width), C.GLsizei(height), C.GLint(border))
+}
+
+// CopyTexSubImage2D calls glCopyTexSubImage2D (GL_ES_VERSION_2_0).
+func CopyTexSubImage2D(target Enum, level int32, xoffset int32, yoffset int32, x int32, y int32, width int32, height int32) {
+	C.callCopyTexSubImage2D(C.uintptr_t(proc(procCopyTexSubImage2D)), C.GLenum(target), C.GLint(level), C.GLint(xoffset), C.GLint(yoffset), C.GLint(x), C.GLint(y), C.GLsizei(width), C.GLsizei(height))
+}
+
+// CopyTexSubImage3D calls glCopyTexSubImage3D (GL_ES_VERSION_3_0).
+func CopyTexSubImage3D(target Enum, level int32, xoffset int32, yoffset int32, zoffset int32, x int32, y int32, width int32, height int32) {
+	C.callCopyTexSubImage3D(C.uintptr_t(proc(procCopyTexSubImage3D)), C.GLenum(target), C.GLint(level), C.GLint(xoffset), C.GLint(yoffset), C.GLint(zoffset), C.GLint(x), C.GLint(y), C.GLsizei(width), C.GLsizei(height))
+}
+
+// CreateProgram calls glCreateProgram (GL_ES_VERSION_2_0).
+func CreateProgram() uint32 {
+	return uint32(C.callCreateProgram(C.uintptr_t(proc(procCreateProgram))))
+}
+
+// CreateShader calls glCreateShader (GL_ES_VERSION_2_0).
+func CreateShader(typ Enum) uint32 {
+	return uint32(C.callCreateShader(C.uintptr_t(proc(procCreateShader)), C.GLenum(typ)))
+}
+
+// CreateShaderProgramv calls glCreateShaderProgramv (GL_ES_VERSION_3_1).
+func CreateShaderProgramv(typ Enum, count int32, strings **uint8) uint32 {
+	return uint32(C.callCreateShaderProgramv(C.uintptr_t(proc(procCreateShaderProgramv)), C.GLenum(typ), C.GLsizei(count), unsafe.Pointer(strings)))
+}
+
+// CullFace calls glCullFace (GL_ES_VERSION_2_0).
+func CullFace(mode Enum) {
+	C.callCullFace(C.uintptr_t(proc(procCullFace)), C.GLenum(mode))
+}
+
+// DebugMessageControl calls glDebugMessageControl or glDebugMessageControlKHR (GL_ES_VERSION_3_2).
+func DebugMessageControl(source Enum, typ Enum, severity Enum, count int32, ids *uint32, enabled bool) {
+	C.callDebugMessageControl(C.uintptr_t(proc(procDebugMessageControl)), C.GLenum(source), C.GLenum(typ), C.GLenum(severity), C.GLsizei(count), unsafe.Pointer(ids), cbool(enabled))
+}
+
+// DebugMessageInsert calls glDebugMessageInsert or glDebugMessageInsertKHR (GL_ES_VERSION_3_2).
+func DebugMessageInsert(source Enum, typ Enum, id uint32, severity Enum, length int32, buf string) {
+	cs5 := C.CString(buf)
+	defer C.free(unsafe.Pointer(cs5))
+	C.callDebugMessageInsert(C.uintptr_t(proc(procDebugMessageInsert)), C.GLenum(source), C.GLenum(typ), C.GLuint(id), C.GLenum(severity), C.GLsizei(length), unsafe.Pointer(cs5))
+}
+
+// DeleteBuffers calls glDeleteBuffers (GL_ES_VERSION_2_0).
+func DeleteBuffers(n int32, buffers *uint32) {
+	C.callDeleteBuffers(C.uintptr_t(proc(procDeleteBuffers)), C.GLsizei(n), unsafe.Pointer(buffers))
+}
+
+// DeleteFramebuffers calls glDeleteFramebuffers (GL_ES_VERSION_2_0).
+func DeleteFramebuffers(n int32, framebuffers *uint32) {
+	C.callDeleteFramebuffers(C.uintptr_t(proc(procDeleteFramebuffers)), C.GLsizei(n), unsafe.Pointer(framebuffers))
+}
+
+// DeleteProgram calls glDeleteProgram (GL_ES_VERSION_2_0).
+func DeleteProgram(program uint32) {
+	C.callDeleteProgram(C.uintptr_t(proc(procDeleteProgram)), C.GLuint(program))
+}
+
+// DeleteProgramPipelines calls glDeleteProgramPipelines (GL_ES_VERSION_3_1).
+func DeleteProgramPipelines(n int32, pipelines *uint32) {
+	C.callDeleteProgramPipelines(C.uintptr_t(proc(procDeleteProgramPipelines)), C.GLsizei(n), unsafe.Pointer(pipelines))
+}
+
+// DeleteQueries calls glDeleteQueries (GL_ES_VERSION_3_0).
+func DeleteQueries(n int32, ids *uint32) {
+	C.callDeleteQueries(C.uintptr_t(proc(procDeleteQueries)), C.GLsizei(n), unsafe.Pointer(ids))
+}
+
+// DeleteRenderbuffers calls glDeleteRenderbuffers (GL_ES_VERSION_2_0).
+func DeleteRenderbuffers(n int32, renderbuffers *uint32) {
+	C.callDeleteRenderbuffers(C.uintptr_t(proc(procDeleteRenderbuffers)), C.GLsizei(n), unsafe.Pointer(renderbuffers))
+}
+
+// DeleteSamplers calls glDeleteSamplers (GL_ES_VERSION_3_0).
+func DeleteSamplers(count int32, samplers *uint32) {
+	C.callDeleteSamplers(C.uintptr_t(proc(procDeleteSamplers)), C.GLsizei(count), unsafe.Pointer(samplers))
+}
+
+// DeleteShader calls glDeleteShader (GL_ES_VERSION_2_0).
+func DeleteShader(shader uint32) {
+	C.callDeleteShader(C.uintptr_t(proc(procDeleteShader)), C.GLuint(shader))
+}
+
+// DeleteSync calls glDeleteSync (GL_ES_VERSION_3_0).
+func DeleteSync(sync uintptr) {
+	C.callDeleteSync(C.uintptr_t(proc(procDeleteSync)), C.uintptr_t(sync))
+}
+
+// DeleteTextures calls glDeleteTextures (GL_ES_VERSION_2_0).
+func DeleteTextures(n int32, textures *uint32) {
+	C.callDeleteTextures(C.uintptr_t(proc(procDeleteTextures)), C.GLsizei(n), unsafe.Pointer(textures))
+}
+
+// DeleteTransformFeedbacks calls glDeleteTransformFeedbacks (GL_ES_VERSION_3_0).
+func DeleteTransformFeedbacks(n int32, ids *uint32) {
+	C.callDeleteTransformFeedbacks(C.uintptr_t(proc(procDeleteTransformFeedbacks)), C.GLsizei(n), unsafe.Pointer(ids))
+}
+
+// DeleteVertexArrays calls glDeleteVertexArrays (GL_ES_VERSION_3_0).
+func DeleteVertexArrays(n int32, arrays *uint32) {
+	C.callDeleteVertexArrays(C.uintptr_t(proc(procDeleteVertexArrays)), C.GLsizei(n), unsafe.Pointer(arrays))
+}
+
+// DepthFunc calls glDepthFunc (GL_ES_VERSION_2_0).
+func DepthFunc(fn Enum) {
+	C.callDepthFunc(C.uintptr_t(proc(procDepthFunc)), C.GLenum(fn))
+}
+
+// DepthMask calls glDepthMask (GL_ES_VERSION_2_0).
+func DepthMask(flag bool) {
+	C.callDepthMask(C.uintptr_t(proc(procDepthMask)), cbool(flag))
+}
+
+// DepthRangef calls glDepthRangef (GL_ES_VERSION_2_0).
+func DepthRangef(n float32, f float32) {
+	C.callDepthRangef(C.uintptr_t(proc(procDepthRangef)), C.GLfloat(n), C.GLfloat(f))
+}
+
+// DetachShader calls glDetachShader (GL_ES_VERSION_2_0).
+func DetachShader(program uint32, shader uint32) {
+	C.callDetachShader(C.uintptr_t(proc(procDetachShader)), C.GLuint(program), C.GLuint(shader))
+}
+
+// Disable calls glDisable (GL_ES_VERSION_2_0).
+func Disable(cap Enum) {
+	C.callDisable(C.uintptr_t(proc(procDisable)), C.GLenum(cap))
+}
+
+// DisableVertexAttribArray calls glDisableVertexAttribArray (GL_ES_VERSION_2_0).
+func DisableVertexAttribArray(index uint32) {
+	C.callDisableVertexAttribArray(C.uintptr_t(proc(procDisableVertexAttribArray)), C.GLuint(index))
+}
+
+// Disablei calls glDisablei (GL_ES_VERSION_3_2).
+func Disablei(target Enum, index uint32) {
+	C.callDisablei(C.uintptr_t(proc(procDisablei)), C.GLenum(target), C.GLuint(index))
+}
+
+// DispatchCompute calls glDispatchCompute (GL_ES_VERSION_3_1).
+func DispatchCompute(num_groups_x uint32, num_groups_y uint32, num_groups_z uint32) {
+	C.callDispatchCompute(C.uintptr_t(proc(procDispatchCompute)), C.GLuint(num_groups_x), C.GLuint(num_groups_y), C.GLuint(num_groups_z))
+}
+
+// DispatchComputeIndirect calls glDispatchComputeIndirect (GL_ES_VERSION_3_1).
+func DispatchComputeIndirect(indirect int) {
+	C.callDispatchComputeIndirect(C.uintptr_t(proc(procDispatchComputeIndirect)), C.GLintptr(indirect))
+}
+
+// DrawArrays calls glDrawArrays (GL_ES_VERSION_2_0).
+func DrawArrays(mode Enum, first int32, count int32) {
+	C.callDrawArrays(C.uintptr_t(proc(procDrawArrays)), C.GLenum(mode), C.GLint(first), C.GLsizei(count))
+}
+
+// DrawArraysIndirect calls glDrawArraysIndirect (GL_ES_VERSION_3_1).
+func DrawArraysIndirect(mode Enum, indirect unsafe.Pointer) {
+	C.callDrawArraysIndirect(C.uintptr_t(proc(procDrawArraysIndirect)), C.GLenum(mode), indirect)
+}
+
+// DrawArraysInstanced calls glDrawArraysInstanced (GL_ES_VERSION_3_0).
+func DrawArraysInstanced(mode Enum, first int32, count int32, instancecount int32) {
+	C.callDrawArraysInstanced(C.uintptr_t(proc(procDrawArraysInstanced)), C.GLenum(mode), C.GLint(first), C.GLsizei(count), C.GLsizei(instancecount))
+}
+
+// DrawBuffers calls glDrawBuffers (GL_ES_VERSION_3_0).
+func DrawBuffers(n int32, bufs *Enum) {
+	C.callDrawBuffers(C.uintptr_t(proc(procDrawBuffers)), C.GLsizei(n), unsafe.Pointer(bufs))
+}
+
+// DrawElements calls glDrawElements (GL_ES_VERSION_2_0).
+func DrawElements(mode Enum, count int32, typ Enum, indices unsafe.Pointer) {
+	C.callDrawElements(C.uintptr_t(proc(procDrawElements)), C.GLenum(mode), C.GLsizei(count), C.GLenum(typ), indices)
+}
+
+// DrawElementsBaseVertex calls glDrawElementsBaseVertex (GL_ES_VERSION_3_2).
+func DrawElementsBaseVertex(mode Enum, count int32, typ Enum, indices unsafe.Pointer, basevertex int32) {
+	C.callDrawElementsBaseVertex(C.uintptr_t(proc(procDrawElementsBaseVertex)), C.GLenum(mode), C.GLsizei(count), C.GLenum(typ), indices, C.GLint(basevertex))
+}
+
+// DrawElementsIndirect calls glDrawElementsIndirect (GL_ES_VERSION_3_1).
+func DrawElementsIndirect(mode Enum, typ Enum, indirect unsafe.Pointer) {
+	C.callDrawElementsIndirect(C.uintptr_t(proc(procDrawElementsIndirect)), C.GLenum(mode), C.GLenum(typ), indirect)
+}
+
+// DrawElementsInstanced calls glDrawElementsInstanced (GL_ES_VERSION_3_0).
+func DrawElementsInstanced(mode Enum, count int32, typ Enum, indices unsafe.Pointer, instancecount int32) {
+	C.callDrawElementsInstanced(C.uintptr_t(proc(procDrawElementsInstanced)), C.GLenum(mode), C.GLsizei(count), C.GLenum(typ), indices, C.GLsizei(instancecount))
+}
+
+// DrawElementsInstancedBaseVertex calls glDrawElementsInstancedBaseVertex (GL_ES_VERSION_3_2).
+func DrawElementsInstancedBaseVertex(mode Enum, count int32, typ Enum, indices unsafe.Pointer, instancecount int32, basevertex int32) {
+	C.callDrawElementsInstancedBaseVertex(C.uintptr_t(proc(procDrawElementsInstancedBaseVertex)), C.GLenum(mode), C.GLsizei(count), C.GLenum(typ), indices, C.GLsizei(instancecount), C.GLint(basevertex))
+}
+
+// DrawRangeElements calls glDrawRangeElements (GL_ES_VERSION_3_0).
+func DrawRangeElements(mode Enum, start uint32, end uint32, count int32, typ Enum, indices unsafe.Pointer) {
+	C.callDrawRangeElements(C.uintptr_t(proc(procDrawRangeElements)), C.GLenum(mode), C.GLuint(start), C.GLuint(end), C.GLsizei(count), C.GLenum(typ), indices)
+}
+
+// DrawRangeElementsBaseVertex calls glDrawRangeElementsBaseVertex (GL_ES_VERSION_3_2).
+func DrawRangeElementsBaseVertex(mode Enum, start uint32, end uint32, count int32, typ Enum, indices unsafe.Pointer, basevertex int32) {
+	C.callDrawRangeElementsBaseVertex(C.uintptr_t(proc(procDrawRangeElementsBaseVertex)), C.GLenum(mode), C.GLuint(start), C.GLuint(end), C.GLsizei(count), C.GLenum(typ), indices, C.GLint(basevertex))
+}
+
+// Enable calls glEnable (GL_ES_VERSION_2_0).
+func Enable(cap Enum) {
+	C.callEnable(C.uintptr_t(proc(procEnable)), C.GLenum(cap))
+}
+
+// EnableVertexAttribArray calls glEnableVertexAttribArray (GL_ES_VERSION_2_0).
+func EnableVertexAttribArray(index uint32) {
+	C.callEnableVertexAttribArray(C.uintptr_t(proc(procEnableVertexAttribArray)), C.GLuint(index))
+}
+
+// Enablei calls glEnablei (GL_ES_VERSION_3_2).
+func Enablei(target Enum, index uint32) {
+	C.callEnablei(C.uintptr_t(proc(procEnablei)), C.GLenum(target), C.GLuint(index))
+}
+
+// EndQuery calls glEndQuery (GL_ES_VERSION_3_0).
+func EndQuery(target Enum) {
+	C.callEndQuery(C.uintptr_t(proc(procEndQuery)), C.GLenum(target))
+}
+
+// EndTransformFeedback calls glEndTransformFeedback (GL_ES_VERSION_3_0).
+func EndTransformFeedback() {
+	C.callEndTransformFeedback(C.uintptr_t(proc(procEndTransformFeedback)))
+}
+
+// FenceSync calls glFenceSync (GL_ES_VERSION_3_0).
+func FenceSync(condition Enum, flags uint32) uintptr {
+	return uintptr(C.callFenceSync(C.uintptr_t(proc(procFenceSync)), C.GLenum(condition), C.GLbitfield(flags)))
+}
+
+// Finish calls glFinish (GL_ES_VERSION_2_0).
+func Finish() {
+	C.callFinish(C.uintptr_t(proc(procFinish)))
+}
+
+// Flush calls glFlush (GL_ES_VERSION_2_0).
+func Flush() {
+	C.callFlush(C.uintptr_t(proc(procFlush)))
+}
+
+// FlushMappedBufferRange calls glFlushMappedBufferRange (GL_ES_VERSION_3_0).
+func FlushMappedBufferRange(target Enum, offset int, length int) {
+	C.callFlushMappedBufferRange(C.uintptr_t(proc(procFlushMappedBufferRange)), C.GLenum(target), C.GLintptr(offset), C.GLsizeiptr(length))
+}
+
+// FramebufferParameteri calls glFramebufferParameteri (GL_ES_VERSION_3_1).
+func FramebufferParameteri(target Enum, pname Enum, param int32) {
+	C.callFramebufferParameteri(C.uintptr_t(proc(procFramebufferParameteri)), C.GLenum(target), C.GLenum(pname), C.GLint(param))
+}
+
+// FramebufferRenderbuffer calls glFramebufferRenderbuffer (GL_ES_VERSION_2_0).
+func FramebufferRenderbuffer(target Enum, attachment Enum, renderbuffertarget Enum, renderbuffer uint32) {
+	C.callFramebufferRenderbuffer(C.uintptr_t(proc(procFramebufferRenderbuffer)), C.GLenum(target), C.GLenum(attachment), C.GLenum(renderbuffertarget), C.GLuint(renderbuffer))
+}
+
+// FramebufferTexture calls glFramebufferTexture (GL_ES_VERSION_3_2).
+func FramebufferTexture(target Enum, attachment Enum, texture uint32, level int32) {
+	C.callFramebufferTexture(C.uintptr_t(proc(procFramebufferTexture)), C.GLenum(target), C.GLenum(attachment), C.GLuint(texture), C.GLint(level))
+}
+
+// FramebufferTexture2D calls glFramebufferTexture2D (GL_ES_VERSION_2_0).
+func FramebufferTexture2D(target Enum, attachment Enum, textarget Enum, texture uint32, level int32) {
+	C.callFramebufferTexture2D(C.uintptr_t(proc(procFramebufferTexture2D)), C.GLenum(target), C.GLenum(attachment), C.GLenum(textarget), C.GLuint(texture), C.GLint(level))
+}
+
+// FramebufferTextureLayer calls glFramebufferTextureLayer (GL_ES_VERSION_3_0).
+func FramebufferTextureLayer(target Enum, attachment Enum, texture uint32, level int32, layer int32) {
+	C.callFramebufferTextureLayer(C.uintptr_t(proc(procFramebufferTextureLayer)), C.GLenum(target), C.GLenum(attachment), C.GLuint(texture), C.GLint(level), C.GLint(layer))
+}
+
+// FrontFace calls glFrontFace (GL_ES_VERSION_2_0).
+func FrontFace(mode Enum) {
+	C.callFrontFace(C.uintptr_t(proc(procFrontFace)), C.GLenum(mode))
+}
+
+// GenBuffers calls glGenBuffers (GL_ES_VERSION_2_0).
+func GenBuffers(n int32, buffers *uint32) {
+	C.callGenBuffers(C.uintptr_t(proc(procGenBuffers)), C.GLsizei(n), unsafe.Pointer(buffers))
+}
+
+// GenFramebuffers calls glGenFramebuffers (GL_ES_VERSION_2_0).
+func GenFramebuffers(n int32, framebuffers *uint32) {
+	C.callGenFramebuffers(C.uintptr_t(proc(procGenFramebuffers)), C.GLsizei(n), unsafe.Pointer(framebuffers))
+}
+
+// GenProgramPipelines calls glGenProgramPipelines (GL_ES_VERSION_3_1).
+func GenProgramPipelines(n int32, pipelines *uint32) {
+	C.callGenProgramPipelines(C.uintptr_t(proc(procGenProgramPipelines)), C.GLsizei(n), unsafe.Pointer(pipelines))
+}
+
+// GenQueries calls glGenQueries (GL_ES_VERSION_3_0).
+func GenQueries(n int32, ids *uint32) {
+	C.callGenQueries(C.uintptr_t(proc(procGenQueries)), C.GLsizei(n), unsafe.Pointer(ids))
+}
+
+// GenRenderbuffers calls glGenRenderbuffers (GL_ES_VERSION_2_0).
+func GenRenderbuffers(n int32, renderbuffers *uint32) {
+	C.callGenRenderbuffers(C.uintptr_t(proc(procGenRenderbuffers)), C.GLsizei(n), unsafe.Pointer(renderbuffers))
+}
+
+// GenSamplers calls glGenSamplers (GL_ES_VERSION_3_0).
+func GenSamplers(count int32, samplers *uint32) {
+	C.callGenSamplers(C.uintptr_t(proc(procGenSamplers)), C.GLsizei(count), unsafe.Pointer(samplers))
+}
+
+// GenTextures calls glGenTextures (GL_ES_VERSION_2_0).
+func GenTextures(n int32, textures *uint32) {
+	C.callGenTextures(C.uintptr_t(proc(procGenTextures)), C.GLsizei(n), unsafe.Pointer(textures))
+}
+
+// GenTransformFeedbacks calls glGenTransformFeedbacks (GL_ES_VERSION_3_0).
+func GenTransformFeedbacks(n int32, ids *uint32) {
+	C.callGenTransformFeedbacks(C.uintptr_t(proc(procGenTransformFeedbacks)), C.GLsizei(n), unsafe.Pointer(ids))
+}
+
+// GenVertexArrays calls glGenVertexArrays (GL_ES_VERSION_3_0).
+func GenVertexArrays(n int32, arrays *uint32) {
+	C.callGenVertexArrays(C.uintptr_t(proc(procGenVertexArrays)), C.GLsizei(n), unsafe.Pointer(arrays))
+}
+
+// GenerateMipmap calls glGenerateMipmap (GL_ES_VERSION_2_0).
+func GenerateMipmap(target Enum) {
+	C.callGenerateMipmap(C.uintptr_t(proc(procGenerateMipmap)), C.GLenum(target))
+}
+
+// GetActiveAttrib calls glGetActiveAttrib (GL_ES_VERSION_2_0).
+func GetActiveAttrib(program uint32, index uint32, bufSize int32, length *int32, size *int32, typ *Enum, name *uint8) {
+	C.callGetActiveAttrib(C.uintptr_t(proc(procGetActiveAttrib)), C.GLuint(program), C.GLuint(index), C.GLsizei(bufSize), unsafe.Pointer(length), unsafe.Pointer(size), unsafe.Pointer(typ), unsafe.Pointer(name))
+}
+
+// GetActiveUniform calls glGetActiveUniform (GL_ES_VERSION_2_0).
+func GetActiveUniform(program uint32, index uint32, bufSize int32, length *int32, size *int32, typ *Enum, name *uint8) {
+	C.callGetActiveUniform(C.uintptr_t(proc(procGetActiveUniform)), C.GLuint(program), C.GLuint(index), C.GLsizei(bufSize), unsafe.Pointer(length), unsafe.Pointer(size), unsafe.Pointer(typ), unsafe.Pointer(name))
+}
+
+// GetActiveUniformBlockName calls glGetActiveUniformBlockName (GL_ES_VERSION_3_0).
+func GetActiveUniformBlockName(program uint32, uniformBlockIndex uint32, bufSize int32, length *int32, uniformBlockName *uint8) {
+	C.callGetActiveUniformBlockName(C.uintptr_t(proc(procGetActiveUniformBlockName)), C.GLuint(program), C.GLuint(uniformBlockIndex), C.GLsizei(bufSize), unsafe.Pointer(length), unsafe.Pointer(uniformBlockName))
+}
+
+// GetActiveUniformBlockiv calls glGetActiveUniformBlockiv (GL_ES_VERSION_3_0).
+func GetActiveUniformBlockiv(program uint32, uniformBlockIndex uint32, pname Enum, params *int32) {
+	C.callGetActiveUniformBlockiv(C.uintptr_t(proc(procGetActiveUniformBlockiv)), C.GLuint(program), C.GLuint(uniformBlockIndex), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// GetActiveUniformsiv calls glGetActiveUniformsiv (GL_ES_VERSION_3_0).
+func GetActiveUniformsiv(program uint32, uniformCount int32, uniformIndices *uint32, pname Enum, params *int32) {
+	C.callGetActiveUniformsiv(C.uintptr_t(proc(procGetActiveUniformsiv)), C.GLuint(program), C.GLsizei(uniformCount), unsafe.Pointer(uniformIndices), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// GetAttachedShaders calls glGetAttachedShaders (GL_ES_VERSION_2_0).
+func GetAttachedShaders(program uint32, maxCount int32, count *int32, shaders *uint32) {
+	C.callGetAttachedShaders(C.uintptr_t(proc(procGetAttachedShaders)), C.GLuint(program), C.GLsizei(maxCount), unsafe.Pointer(count), unsafe.Pointer(shaders))
+}
+
+// GetAttribLocation calls glGetAttribLocation (GL_ES_VERSION_2_0).
+func GetAttribLocation(program uint32, name string) int32 {
+	cs1 := C.CString(name)
+	defer C.free(unsafe.Pointer(cs1))
+	return int32(C.callGetAttribLocation(C.uintptr_t(proc(procGetAttribLocation)), C.GLuint(program), unsafe.Pointer(cs1)))
+}
+
+// GetBooleani_v calls glGetBooleani_v (GL_ES_VERSION_3_1).
+func GetBooleani_v(target Enum, index uint32, data *bool) {
+	C.callGetBooleani_v(C.uintptr_t(proc(procGetBooleani_v)), C.GLenum(target), C.GLuint(index), unsafe.Pointer(data))
+}
+
+// GetBooleanv calls glGetBooleanv (GL_ES_VERSION_2_0).
+func GetBooleanv(pname Enum, data *bool) {
+	C.callGetBooleanv(C.uintptr_t(proc(procGetBooleanv)), C.GLenum(pname), unsafe.Pointer(data))
+}
+
+// GetBufferParameteri64v calls glGetBufferParameteri64v (GL_ES_VERSION_3_0).
+func GetBufferParameteri64v(target Enum, pname Enum, params *int64) {
+	C.callGetBufferParameteri64v(C.uintptr_t(proc(procGetBufferParameteri64v)), C.GLenum(target), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// GetBufferParameteriv calls glGetBufferParameteriv (GL_ES_VERSION_2_0).
+func GetBufferParameteriv(target Enum, pname Enum, params *int32) {
+	C.callGetBufferParameteriv(C.uintptr_t(proc(procGetBufferParameteriv)), C.GLenum(target), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// GetBufferPointerv calls glGetBufferPointerv (GL_ES_VERSION_3_0).
+func GetBufferPointerv(target Enum, pname Enum, params *unsafe.Pointer) {
+	C.callGetBufferPointerv(C.uintptr_t(proc(procGetBufferPointerv)), C.GLenum(target), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// GetDebugMessageLog calls glGetDebugMessageLog or glGetDebugMessageLogKHR (GL_ES_VERSION_3_2).
+func GetDebugMessageLog(count uint32, bufSize int32, sources *Enum, types *Enum, ids *uint32, severities *Enum, lengths *int32, messageLog *uint8) uint32 {
+	return uint32(C.callGetDebugMessageLog(C.uintptr_t(proc(procGetDebugMessageLog)), C.GLuint(count), C.GLsizei(bufSize), unsafe.Pointer(sources), unsafe.Pointer(types), unsafe.Pointer(ids), unsafe.Pointer(severities), unsafe.Pointer(lengths), unsafe.Pointer(messageLog)))
+}
+
+// GetError calls glGetError (GL_ES_VERSION_2_0).
+func GetError() Enum {
+	return Enum(C.callGetError(C.uintptr_t(proc(procGetError))))
+}
+
+// GetFloatv calls glGetFloatv (GL_ES_VERSION_2_0).
+func GetFloatv(pname Enum, data *float32) {
+	C.callGetFloatv(C.uintptr_t(proc(procGetFloatv)), C.GLenum(pname), unsafe.Pointer(data))
+}
+
+// GetFragDataLocation calls glGetFragDataLocation (GL_ES_VERSION_3_0).
+func GetFragDataLocation(program uint32, name string) int32 {
+	cs1 := C.CString(name)
+	defer C.free(unsafe.Pointer(cs1))
+	return int32(C.callGetFragDataLocation(C.uintptr_t(proc(procGetFragDataLocation)), C.GLuint(program), unsafe.Pointer(cs1)))
+}
+
+// GetFramebufferAttachmentParameteriv calls glGetFramebufferAttachmentParameteriv (GL_ES_VERSION_2_0).
+func GetFramebufferAttachmentParameteriv(target Enum, attachment Enum, pname Enum, params *int32) {
+	C.callGetFramebufferAttachmentParameteriv(C.uintptr_t(proc(procGetFramebufferAttachmentParameteriv)), C.GLenum(target), C.GLenum(attachment), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// GetFramebufferParameteriv calls glGetFramebufferParameteriv (GL_ES_VERSION_3_1).
+func GetFramebufferParameteriv(target Enum, pname Enum, params *int32) {
+	C.callGetFramebufferParameteriv(C.uintptr_t(proc(procGetFramebufferParameteriv)), C.GLenum(target), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// GetGraphicsResetStatus calls glGetGraphicsResetStatus (GL_ES_VERSION_3_2).
+func GetGraphicsResetStatus() Enum {
+	return Enum(C.callGetGraphicsResetStatus(C.uintptr_t(proc(procGetGraphicsResetStatus))))
+}
+
+// GetInteger64i_v calls glGetInteger64i_v (GL_ES_VERSION_3_0).
+func GetInteger64i_v(target Enum, index uint32, data *int64) {
+	C.callGetInteger64i_v(C.uintptr_t(proc(procGetInteger64i_v)), C.GLenum(target), C.GLuint(index), unsafe.Pointer(data))
+}
+
+// GetInteger64v calls glGetInteger64v (GL_ES_VERSION_3_0).
+func GetInteger64v(pname Enum, data *int64) {
+	C.callGetInteger64v(C.uintptr_t(proc(procGetInteger64v)), C.GLenum(pname), unsafe.Pointer(data))
+}
+
+// GetIntegeri_v calls glGetIntegeri_v (GL_ES_VERSION_3_0).
+func GetIntegeri_v(target Enum, index uint32, data *int32) {
+	C.callGetIntegeri_v(C.uintptr_t(proc(procGetIntegeri_v)), C.GLenum(target), C.GLuint(index), unsafe.Pointer(data))
+}
+
+// GetIntegerv calls glGetIntegerv (GL_ES_VERSION_2_0).
+func GetIntegerv(pname Enum, data *int32) {
+	C.callGetIntegerv(C.uintptr_t(proc(procGetIntegerv)), C.GLenum(pname), unsafe.Pointer(data))
+}
+
+// GetInternalformativ calls glGetInternalformativ (GL_ES_VERSION_3_0).
+func GetInternalformativ(target Enum, internalformat Enum, pname Enum, bufSize int32, params *int32) {
+	C.callGetInternalformativ(C.uintptr_t(proc(procGetInternalformativ)), C.GLenum(target), C.GLenum(internalformat), C.GLenum(pname), C.GLsizei(bufSize), unsafe.Pointer(params))
+}
+
+// GetMultisamplefv calls glGetMultisamplefv (GL_ES_VERSION_3_1).
+func GetMultisamplefv(pname Enum, index uint32, val *float32) {
+	C.callGetMultisamplefv(C.uintptr_t(proc(procGetMultisamplefv)), C.GLenum(pname), C.GLuint(index), unsafe.Pointer(val))
+}
+
+// GetObjectLabel calls glGetObjectLabel or glGetObjectLabelKHR (GL_ES_VERSION_3_2).
+func GetObjectLabel(identifier Enum, name uint32, bufSize int32, length *int32, label *uint8) {
+	C.callGetObjectLabel(C.uintptr_t(proc(procGetObjectLabel)), C.GLenum(identifier), C.GLuint(name), C.GLsizei(bufSize), unsafe.Pointer(length), unsafe.Pointer(label))
+}
+
+// GetObjectPtrLabel calls glGetObjectPtrLabel or glGetObjectPtrLabelKHR (GL_ES_VERSION_3_2).
+func GetObjectPtrLabel(ptr unsafe.Pointer, bufSize int32, length *int32, label *uint8) {
+	C.callGetObjectPtrLabel(C.uintptr_t(proc(procGetObjectPtrLabel)), ptr, C.GLsizei(bufSize), unsafe.Pointer(length), unsafe.Pointer(label))
+}
+
+// GetPointerv calls glGetPointerv or glGetPointervKHR (GL_ES_VERSION_3_2).
+func GetPointerv(pname Enum, params *unsafe.Pointer) {
+	C.callGetPointerv(C.uintptr_t(proc(procGetPointerv)), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// GetProgramBinary calls glGetProgramBinary (GL_ES_VERSION_3_0).
+func GetProgramBinary(program uint32, bufSize int32, length *int32, binaryFormat *Enum, binary unsafe.Pointer) {
+	C.callGetProgramBinary(C.uintptr_t(proc(procGetProgramBinary)), C.GLuint(program), C.GLsizei(bufSize), unsafe.Pointer(length), unsafe.Pointer(binaryFormat), binary)
+}
+
+// GetProgramInfoLog calls glGetProgramInfoLog (GL_ES_VERSION_2_0).
+func GetProgramInfoLog(program uint32, bufSize int32, length *int32, infoLog *uint8) {
+	C.callGetProgramInfoLog(C.uintptr_t(proc(procGetProgramInfoLog)), C.GLuint(program), C.GLsizei(bufSize), unsafe.Pointer(length), unsafe.Pointer(infoLog))
+}
+
+// GetProgramInterfaceiv calls glGetProgramInterfaceiv (GL_ES_VERSION_3_1).
+func GetProgramInterfaceiv(program uint32, programInterface Enum, pname Enum, params *int32) {
+	C.callGetProgramInterfaceiv(C.uintptr_t(proc(procGetProgramInterfaceiv)), C.GLuint(program), C.GLenum(programInterface), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// GetProgramPipelineInfoLog calls glGetProgramPipelineInfoLog (GL_ES_VERSION_3_1).
+func GetProgramPipelineInfoLog(pipeline uint32, bufSize int32, length *int32, infoLog *uint8) {
+	C.callGetProgramPipelineInfoLog(C.uintptr_t(proc(procGetProgramPipelineInfoLog)), C.GLuint(pipeline), C.GLsizei(bufSize), unsafe.Pointer(length), unsafe.Pointer(infoLog))
+}
+
+// GetProgramPipelineiv calls glGetProgramPipelineiv (GL_ES_VERSION_3_1).
+func GetProgramPipelineiv(pipeline uint32, pname Enum, params *int32) {
+	C.callGetProgramPipelineiv(C.uintptr_t(proc(procGetProgramPipelineiv)), C.GLuint(pipeline), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// GetProgramResourceIndex calls glGetProgramResourceIndex (GL_ES_VERSION_3_1).
+func GetProgramResourceIndex(program uint32, programInterface Enum, name string) uint32 {
+	cs2 := C.CString(name)
+	defer C.free(unsafe.Pointer(cs2))
+	return uint32(C.callGetProgramResourceIndex(C.uintptr_t(proc(procGetProgramResourceIndex)), C.GLuint(program), C.GLenum(programInterface), unsafe.Pointer(cs2)))
+}
+
+// GetProgramResourceLocation calls glGetProgramResourceLocation (GL_ES_VERSION_3_1).
+func GetProgramResourceLocation(program uint32, programInterface Enum, name string) int32 {
+	cs2 := C.CString(name)
+	defer C.free(unsafe.Pointer(cs2))
+	return int32(C.callGetProgramResourceLocation(C.uintptr_t(proc(procGetProgramResourceLocation)), C.GLuint(program), C.GLenum(programInterface), unsafe.Pointer(cs2)))
+}
+
+// GetProgramResourceName calls glGetProgramResourceName (GL_ES_VERSION_3_1).
+func GetProgramResourceName(program uint32, programInterface Enum, index uint32, bufSize int32, length *int32, name *uint8) {
+	C.callGetProgramResourceName(C.uintptr_t(proc(procGetProgramResourceName)), C.GLuint(program), C.GLenum(programInterface), C.GLuint(index), C.GLsizei(bufSize), unsafe.Pointer(length), unsafe.Pointer(name))
+}
+
+// GetProgramResourceiv calls glGetProgramResourceiv (GL_ES_VERSION_3_1).
+func GetProgramResourceiv(program uint32, programInterface Enum, index uint32, propCount int32, props *Enum, bufSize int32, length *int32, params *int32) {
+	C.callGetProgramResourceiv(C.uintptr_t(proc(procGetProgramResourceiv)), C.GLuint(program), C.GLenum(programInterface), C.GLuint(index), C.GLsizei(propCount), unsafe.Pointer(props), C.GLsizei(bufSize), unsafe.Pointer(length), unsafe.Pointer(params))
+}
+
+// GetProgramiv calls glGetProgramiv (GL_ES_VERSION_2_0).
+func GetProgramiv(program uint32, pname Enum, params *int32) {
+	C.callGetProgramiv(C.uintptr_t(proc(procGetProgramiv)), C.GLuint(program), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// GetQueryObjectuiv calls glGetQueryObjectuiv (GL_ES_VERSION_3_0).
+func GetQueryObjectuiv(id uint32, pname Enum, params *uint32) {
+	C.callGetQueryObjectuiv(C.uintptr_t(proc(procGetQueryObjectuiv)), C.GLuint(id), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// GetQueryiv calls glGetQueryiv (GL_ES_VERSION_3_0).
+func GetQueryiv(target Enum, pname Enum, params *int32) {
+	C.callGetQueryiv(C.uintptr_t(proc(procGetQueryiv)), C.GLenum(target), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// GetRenderbufferParameteriv calls glGetRenderbufferParameteriv (GL_ES_VERSION_2_0).
+func GetRenderbufferParameteriv(target Enum, pname Enum, params *int32) {
+	C.callGetRenderbufferParameteriv(C.uintptr_t(proc(procGetRenderbufferParameteriv)), C.GLenum(target), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// GetSamplerParameterIiv calls glGetSamplerParameterIiv (GL_ES_VERSION_3_2).
+func GetSamplerParameterIiv(sampler uint32, pname Enum, params *int32) {
+	C.callGetSamplerParameterIiv(C.uintptr_t(proc(procGetSamplerParameterIiv)), C.GLuint(sampler), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// GetSamplerParameterIuiv calls glGetSamplerParameterIuiv (GL_ES_VERSION_3_2).
+func GetSamplerParameterIuiv(sampler uint32, pname Enum, params *uint32) {
+	C.callGetSamplerParameterIuiv(C.uintptr_t(proc(procGetSamplerParameterIuiv)), C.GLuint(sampler), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// GetSamplerParameterfv calls glGetSamplerParameterfv (GL_ES_VERSION_3_0).
+func GetSamplerParameterfv(sampler uint32, pname Enum, params *float32) {
+	C.callGetSamplerParameterfv(C.uintptr_t(proc(procGetSamplerParameterfv)), C.GLuint(sampler), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// GetSamplerParameteriv calls glGetSamplerParameteriv (GL_ES_VERSION_3_0).
+func GetSamplerParameteriv(sampler uint32, pname Enum, params *int32) {
+	C.callGetSamplerParameteriv(C.uintptr_t(proc(procGetSamplerParameteriv)), C.GLuint(sampler), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// GetShaderInfoLog calls glGetShaderInfoLog (GL_ES_VERSION_2_0).
+func GetShaderInfoLog(shader uint32, bufSize int32, length *int32, infoLog *uint8) {
+	C.callGetShaderInfoLog(C.uintptr_t(proc(procGetShaderInfoLog)), C.GLuint(shader), C.GLsizei(bufSize), unsafe.Pointer(length), unsafe.Pointer(infoLog))
+}
+
+// GetShaderPrecisionFormat calls glGetShaderPrecisionFormat (GL_ES_VERSION_2_0).
+func GetShaderPrecisionFormat(shadertype Enum, precisiontype Enum, rng *int32, precision *int32) {
+	C.callGetShaderPrecisionFormat(C.uintptr_t(proc(procGetShaderPrecisionFormat)), C.GLenum(shadertype), C.GLenum(precisiontype), unsafe.Pointer(rng), unsafe.Pointer(precision))
+}
+
+// GetShaderSource calls glGetShaderSource (GL_ES_VERSION_2_0).
+func GetShaderSource(shader uint32, bufSize int32, length *int32, source *uint8) {
+	C.callGetShaderSource(C.uintptr_t(proc(procGetShaderSource)), C.GLuint(shader), C.GLsizei(bufSize), unsafe.Pointer(length), unsafe.Pointer(source))
+}
+
+// GetShaderiv calls glGetShaderiv (GL_ES_VERSION_2_0).
+func GetShaderiv(shader uint32, pname Enum, params *int32) {
+	C.callGetShaderiv(C.uintptr_t(proc(procGetShaderiv)), C.GLuint(shader), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// GetString calls glGetString (GL_ES_VERSION_2_0).
+func GetString(name Enum) string {
+	return C.GoString(C.callGetString(C.uintptr_t(proc(procGetString)), C.GLenum(name)))
+}
+
+// GetStringi calls glGetStringi (GL_ES_VERSION_3_0).
+func GetStringi(name Enum, index uint32) string {
+	return C.GoString(C.callGetStringi(C.uintptr_t(proc(procGetStringi)), C.GLenum(name), C.GLuint(index)))
+}
+
+// GetSynciv calls glGetSynciv (GL_ES_VERSION_3_0).
+func GetSynciv(sync uintptr, pname Enum, bufSize int32, length *int32, values *int32) {
+	C.callGetSynciv(C.uintptr_t(proc(procGetSynciv)), C.uintptr_t(sync), C.GLenum(pname), C.GLsizei(bufSize), unsafe.Pointer(length), unsafe.Pointer(values))
+}
+
+// GetTexLevelParameterfv calls glGetTexLevelParameterfv (GL_ES_VERSION_3_1).
+func GetTexLevelParameterfv(target Enum, level int32, pname Enum, params *float32) {
+	C.callGetTexLevelParameterfv(C.uintptr_t(proc(procGetTexLevelParameterfv)), C.GLenum(target), C.GLint(level), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// GetTexLevelParameteriv calls glGetTexLevelParameteriv (GL_ES_VERSION_3_1).
+func GetTexLevelParameteriv(target Enum, level int32, pname Enum, params *int32) {
+	C.callGetTexLevelParameteriv(C.uintptr_t(proc(procGetTexLevelParameteriv)), C.GLenum(target), C.GLint(level), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// GetTexParameterIiv calls glGetTexParameterIiv (GL_ES_VERSION_3_2).
+func GetTexParameterIiv(target Enum, pname Enum, params *int32) {
+	C.callGetTexParameterIiv(C.uintptr_t(proc(procGetTexParameterIiv)), C.GLenum(target), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// GetTexParameterIuiv calls glGetTexParameterIuiv (GL_ES_VERSION_3_2).
+func GetTexParameterIuiv(target Enum, pname Enum, params *uint32) {
+	C.callGetTexParameterIuiv(C.uintptr_t(proc(procGetTexParameterIuiv)), C.GLenum(target), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// GetTexParameterfv calls glGetTexParameterfv (GL_ES_VERSION_2_0).
+func GetTexParameterfv(target Enum, pname Enum, params *float32) {
+	C.callGetTexParameterfv(C.uintptr_t(proc(procGetTexParameterfv)), C.GLenum(target), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// GetTexParameteriv calls glGetTexParameteriv (GL_ES_VERSION_2_0).
+func GetTexParameteriv(target Enum, pname Enum, params *int32) {
+	C.callGetTexParameteriv(C.uintptr_t(proc(procGetTexParameteriv)), C.GLenum(target), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// GetTransformFeedbackVarying calls glGetTransformFeedbackVarying (GL_ES_VERSION_3_0).
+func GetTransformFeedbackVarying(program uint32, index uint32, bufSize int32, length *int32, size *int32, typ *Enum, name *uint8) {
+	C.callGetTransformFeedbackVarying(C.uintptr_t(proc(procGetTransformFeedbackVarying)), C.GLuint(program), C.GLuint(index), C.GLsizei(bufSize), unsafe.Pointer(length), unsafe.Pointer(size), unsafe.Pointer(typ), unsafe.Pointer(name))
+}
+
+// GetUniformBlockIndex calls glGetUniformBlockIndex (GL_ES_VERSION_3_0).
+func GetUniformBlockIndex(program uint32, uniformBlockName string) uint32 {
+	cs1 := C.CString(uniformBlockName)
+	defer C.free(unsafe.Pointer(cs1))
+	return uint32(C.callGetUniformBlockIndex(C.uintptr_t(proc(procGetUniformBlockIndex)), C.GLuint(program), unsafe.Pointer(cs1)))
+}
+
+// GetUniformIndices calls glGetUniformIndices (GL_ES_VERSION_3_0).
+func GetUniformIndices(program uint32, uniformCount int32, uniformNames **uint8, uniformIndices *uint32) {
+	C.callGetUniformIndices(C.uintptr_t(proc(procGetUniformIndices)), C.GLuint(program), C.GLsizei(uniformCount), unsafe.Pointer(uniformNames), unsafe.Pointer(uniformIndices))
+}
+
+// GetUniformLocation calls glGetUniformLocation (GL_ES_VERSION_2_0).
+func GetUniformLocation(program uint32, name string) int32 {
+	cs1 := C.CString(name)
+	defer C.free(unsafe.Pointer(cs1))
+	return int32(C.callGetUniformLocation(C.uintptr_t(proc(procGetUniformLocation)), C.GLuint(program), unsafe.Pointer(cs1)))
+}
+
+// GetUniformfv calls glGetUniformfv (GL_ES_VERSION_2_0).
+func GetUniformfv(program uint32, location int32, params *float32) {
+	C.callGetUniformfv(C.uintptr_t(proc(procGetUniformfv)), C.GLuint(program), C.GLint(location), unsafe.Pointer(params))
+}
+
+// GetUniformiv calls glGetUniformiv (GL_ES_VERSION_2_0).
+func GetUniformiv(program uint32, location int32, params *int32) {
+	C.callGetUniformiv(C.uintptr_t(proc(procGetUniformiv)), C.GLuint(program), C.GLint(location), unsafe.Pointer(params))
+}
+
+// GetUniformuiv calls glGetUniformuiv (GL_ES_VERSION_3_0).
+func GetUniformuiv(program uint32, location int32, params *uint32) {
+	C.callGetUniformuiv(C.uintptr_t(proc(procGetUniformuiv)), C.GLuint(program), C.GLint(location), unsafe.Pointer(params))
+}
+
+// GetVertexAttribIiv calls glGetVertexAttribIiv (GL_ES_VERSION_3_0).
+func GetVertexAttribIiv(index uint32, pname Enum, params *int32) {
+	C.callGetVertexAttribIiv(C.uintptr_t(proc(procGetVertexAttribIiv)), C.GLuint(index), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// GetVertexAttribIuiv calls glGetVertexAttribIuiv (GL_ES_VERSION_3_0).
+func GetVertexAttribIuiv(index uint32, pname Enum, params *uint32) {
+	C.callGetVertexAttribIuiv(C.uintptr_t(proc(procGetVertexAttribIuiv)), C.GLuint(index), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// GetVertexAttribPointerv calls glGetVertexAttribPointerv (GL_ES_VERSION_2_0).
+func GetVertexAttribPointerv(index uint32, pname Enum, pointer *unsafe.Pointer) {
+	C.callGetVertexAttribPointerv(C.uintptr_t(proc(procGetVertexAttribPointerv)), C.GLuint(index), C.GLenum(pname), unsafe.Pointer(pointer))
+}
+
+// GetVertexAttribfv calls glGetVertexAttribfv (GL_ES_VERSION_2_0).
+func GetVertexAttribfv(index uint32, pname Enum, params *float32) {
+	C.callGetVertexAttribfv(C.uintptr_t(proc(procGetVertexAttribfv)), C.GLuint(index), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// GetVertexAttribiv calls glGetVertexAttribiv (GL_ES_VERSION_2_0).
+func GetVertexAttribiv(index uint32, pname Enum, params *int32) {
+	C.callGetVertexAttribiv(C.uintptr_t(proc(procGetVertexAttribiv)), C.GLuint(index), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// GetnUniformfv calls glGetnUniformfv (GL_ES_VERSION_3_2).
+func GetnUniformfv(program uint32, location int32, bufSize int32, params *float32) {
+	C.callGetnUniformfv(C.uintptr_t(proc(procGetnUniformfv)), C.GLuint(program), C.GLint(location), C.GLsizei(bufSize), unsafe.Pointer(params))
+}
+
+// GetnUniformiv calls glGetnUniformiv (GL_ES_VERSION_3_2).
+func GetnUniformiv(program uint32, location int32, bufSize int32, params *int32) {
+	C.callGetnUniformiv(C.uintptr_t(proc(procGetnUniformiv)), C.GLuint(program), C.GLint(location), C.GLsizei(bufSize), unsafe.Pointer(params))
+}
+
+// GetnUniformuiv calls glGetnUniformuiv (GL_ES_VERSION_3_2).
+func GetnUniformuiv(program uint32, location int32, bufSize int32, params *uint32) {
+	C.callGetnUniformuiv(C.uintptr_t(proc(procGetnUniformuiv)), C.GLuint(program), C.GLint(location), C.GLsizei(bufSize), unsafe.Pointer(params))
+}
+
+// Hint calls glHint (GL_ES_VERSION_2_0).
+func Hint(target Enum, mode Enum) {
+	C.callHint(C.uintptr_t(proc(procHint)), C.GLenum(target), C.GLenum(mode))
+}
+
+// InvalidateFramebuffer calls glInvalidateFramebuffer (GL_ES_VERSION_3_0).
+func InvalidateFramebuffer(target Enum, numAttachments int32, attachments *Enum) {
+	C.callInvalidateFramebuffer(C.uintptr_t(proc(procInvalidateFramebuffer)), C.GLenum(target), C.GLsizei(numAttachments), unsafe.Pointer(attachments))
+}
+
+// InvalidateSubFramebuffer calls glInvalidateSubFramebuffer (GL_ES_VERSION_3_0).
+func InvalidateSubFramebuffer(target Enum, numAttachments int32, attachments *Enum, x int32, y int32, width int32, height int32) {
+	C.callInvalidateSubFramebuffer(C.uintptr_t(proc(procInvalidateSubFramebuffer)), C.GLenum(target), C.GLsizei(numAttachments), unsafe.Pointer(attachments), C.GLint(x), C.GLint(y), C.GLsizei(width), C.GLsizei(height))
+}
+
+// IsBuffer calls glIsBuffer (GL_ES_VERSION_2_0).
+func IsBuffer(buffer uint32) bool {
+	return C.callIsBuffer(C.uintptr_t(proc(procIsBuffer)), C.GLuint(buffer)) != 0
+}
+
+// IsEnabled calls glIsEnabled (GL_ES_VERSION_2_0).
+func IsEnabled(cap Enum) bool {
+	return C.callIsEnabled(C.uintptr_t(proc(procIsEnabled)), C.GLenum(cap)) != 0
+}
+
+// IsEnabledi calls glIsEnabledi (GL_ES_VERSION_3_2).
+func IsEnabledi(target Enum, index uint32) bool {
+	return C.callIsEnabledi(C.uintptr_t(proc(procIsEnabledi)), C.GLenum(target), C.GLuint(index)) != 0
+}
+
+// IsFramebuffer calls glIsFramebuffer (GL_ES_VERSION_2_0).
+func IsFramebuffer(framebuffer uint32) bool {
+	return C.callIsFramebuffer(C.uintptr_t(proc(procIsFramebuffer)), C.GLuint(framebuffer)) != 0
+}
+
+// IsProgram calls glIsProgram (GL_ES_VERSION_2_0).
+func IsProgram(program uint32) bool {
+	return C.callIsProgram(C.uintptr_t(proc(procIsProgram)), C.GLuint(program)) != 0
+}
+
+// IsProgramPipeline calls glIsProgramPipeline (GL_ES_VERSION_3_1).
+func IsProgramPipeline(pipeline uint32) bool {
+	return C.callIsProgramPipeline(C.uintptr_t(proc(procIsProgramPipeline)), C.GLuint(pipeline)) != 0
+}
+
+// IsQuery calls glIsQuery (GL_ES_VERSION_3_0).
+func IsQuery(id uint32) bool {
+	return C.callIsQuery(C.uintptr_t(proc(procIsQuery)), C.GLuint(id)) != 0
+}
+
+// IsRenderbuffer calls glIsRenderbuffer (GL_ES_VERSION_2_0).
+func IsRenderbuffer(renderbuffer uint32) bool {
+	return C.callIsRenderbuffer(C.uintptr_t(proc(procIsRenderbuffer)), C.GLuint(renderbuffer)) != 0
+}
+
+// IsSampler calls glIsSampler (GL_ES_VERSION_3_0).
+func IsSampler(sampler uint32) bool {
+	return C.callIsSampler(C.uintptr_t(proc(procIsSampler)), C.GLuint(sampler)) != 0
+}
+
+// IsShader calls glIsShader (GL_ES_VERSION_2_0).
+func IsShader(shader uint32) bool {
+	return C.callIsShader(C.uintptr_t(proc(procIsShader)), C.GLuint(shader)) != 0
+}
+
+// IsSync calls glIsSync (GL_ES_VERSION_3_0).
+func IsSync(sync uintptr) bool {
+	return C.callIsSync(C.uintptr_t(proc(procIsSync)), C.uintptr_t(sync)) != 0
+}
+
+// IsTexture calls glIsTexture (GL_ES_VERSION_2_0).
+func IsTexture(texture uint32) bool {
+	return C.callIsTexture(C.uintptr_t(proc(procIsTexture)), C.GLuint(texture)) != 0
+}
+
+// IsTransformFeedback calls glIsTransformFeedback (GL_ES_VERSION_3_0).
+func IsTransformFeedback(id uint32) bool {
+	return C.callIsTransformFeedback(C.uintptr_t(proc(procIsTransformFeedback)), C.GLuint(id)) != 0
+}
+
+// IsVertexArray calls glIsVertexArray (GL_ES_VERSION_3_0).
+func IsVertexArray(array uint32) bool {
+	return C.callIsVertexArray(C.uintptr_t(proc(procIsVertexArray)), C.GLuint(array)) != 0
+}
+
+// LineWidth calls glLineWidth (GL_ES_VERSION_2_0).
+func LineWidth(width float32) {
+	C.callLineWidth(C.uintptr_t(proc(procLineWidth)), C.GLfloat(width))
+}
+
+// LinkProgram calls glLinkProgram (GL_ES_VERSION_2_0).
+func LinkProgram(program uint32) {
+	C.callLinkProgram(C.uintptr_t(proc(procLinkProgram)), C.GLuint(program))
+}
+
+// MapBufferRange calls glMapBufferRange (GL_ES_VERSION_3_0).
+func MapBufferRange(target Enum, offset int, length int, access uint32) unsafe.Pointer {
+	return C.callMapBufferRange(C.uintptr_t(proc(procMapBufferRange)), C.GLenum(target), C.GLintptr(offset), C.GLsizeiptr(length), C.GLbitfield(access))
+}
+
+// MemoryBarrier calls glMemoryBarrier (GL_ES_VERSION_3_1).
+func MemoryBarrier(barriers uint32) {
+	C.callMemoryBarrier(C.uintptr_t(proc(procMemoryBarrier)), C.GLbitfield(barriers))
+}
+
+// MemoryBarrierByRegion calls glMemoryBarrierByRegion (GL_ES_VERSION_3_1).
+func MemoryBarrierByRegion(barriers uint32) {
+	C.callMemoryBarrierByRegion(C.uintptr_t(proc(procMemoryBarrierByRegion)), C.GLbitfield(barriers))
+}
+
+// MinSampleShading calls glMinSampleShading (GL_ES_VERSION_3_2).
+func MinSampleShading(value float32) {
+	C.callMinSampleShading(C.uintptr_t(proc(procMinSampleShading)), C.GLfloat(value))
+}
+
+// ObjectLabel calls glObjectLabel or glObjectLabelKHR (GL_ES_VERSION_3_2).
+func ObjectLabel(identifier Enum, name uint32, length int32, label string) {
+	cs3 := C.CString(label)
+	defer C.free(unsafe.Pointer(cs3))
+	C.callObjectLabel(C.uintptr_t(proc(procObjectLabel)), C.GLenum(identifier), C.GLuint(name), C.GLsizei(length), unsafe.Pointer(cs3))
+}
+
+// ObjectPtrLabel calls glObjectPtrLabel or glObjectPtrLabelKHR (GL_ES_VERSION_3_2).
+func ObjectPtrLabel(ptr unsafe.Pointer, length int32, label string) {
+	cs2 := C.CString(label)
+	defer C.free(unsafe.Pointer(cs2))
+	C.callObjectPtrLabel(C.uintptr_t(proc(procObjectPtrLabel)), ptr, C.GLsizei(length), unsafe.Pointer(cs2))
+}
+
+// PatchParameteri calls glPatchParameteri (GL_ES_VERSION_3_2).
+func PatchParameteri(pname Enum, value int32) {
+	C.callPatchParameteri(C.uintptr_t(proc(procPatchParameteri)), C.GLenum(pname), C.GLint(value))
+}
+
+// PauseTransformFeedback calls glPauseTransformFeedback (GL_ES_VERSION_3_0).
+func PauseTransformFeedback() {
+	C.callPauseTransformFeedback(C.uintptr_t(proc(procPauseTransformFeedback)))
+}
+
+// PixelStorei calls glPixelStorei (GL_ES_VERSION_2_0).
+func PixelStorei(pname Enum, param int32) {
+	C.callPixelStorei(C.uintptr_t(proc(procPixelStorei)), C.GLenum(pname), C.GLint(param))
+}
+
+// PolygonOffset calls glPolygonOffset (GL_ES_VERSION_2_0).
+func PolygonOffset(factor float32, units float32) {
+	C.callPolygonOffset(C.uintptr_t(proc(procPolygonOffset)), C.GLfloat(factor), C.GLfloat(units))
+}
+
+// PopDebugGroup calls glPopDebugGroup or glPopDebugGroupKHR (GL_ES_VERSION_3_2).
+func PopDebugGroup() {
+	C.callPopDebugGroup(C.uintptr_t(proc(procPopDebugGroup)))
+}
+
+// PrimitiveBoundingBox calls glPrimitiveBoundingBox (GL_ES_VERSION_3_2).
+func PrimitiveBoundingBox(minX float32, minY float32, minZ float32, minW float32, maxX float32, maxY float32, maxZ float32, maxW float32) {
+	C.callPrimitiveBoundingBox(C.uintptr_t(proc(procPrimitiveBoundingBox)), C.GLfloat(minX), C.GLfloat(minY), C.GLfloat(minZ), C.GLfloat(minW), C.GLfloat(maxX), C.GLfloat(maxY), C.GLfloat(maxZ), C.GLfloat(maxW))
+}
+
+// ProgramBinary calls glProgramBinary (GL_ES_VERSION_3_0).
+func ProgramBinary(program uint32, binaryFormat Enum, binary unsafe.Pointer, length int32) {
+	C.callProgramBinary(C.uintptr_t(proc(procProgramBinary)), C.GLuint(program), C.GLenum(binaryFormat), binary, C.GLsizei(length))
+}
+
+// ProgramParameteri calls glProgramParameteri (GL_ES_VERSION_3_0).
+func ProgramParameteri(program uint32, pname Enum, value int32) {
+	C.callProgramParameteri(C.uintptr_t(proc(procProgramParameteri)), C.GLuint(program), C.GLenum(pname), C.GLint(value))
+}
+
+// ProgramUniform1f calls glProgramUniform1f (GL_ES_VERSION_3_1).
+func ProgramUniform1f(program uint32, location int32, v0 float32) {
+	C.callProgramUniform1f(C.uintptr_t(proc(procProgramUniform1f)), C.GLuint(program), C.GLint(location), C.GLfloat(v0))
+}
+
+// ProgramUniform1fv calls glProgramUniform1fv (GL_ES_VERSION_3_1).
+func ProgramUniform1fv(program uint32, location int32, count int32, value *float32) {
+	C.callProgramUniform1fv(C.uintptr_t(proc(procProgramUniform1fv)), C.GLuint(program), C.GLint(location), C.GLsizei(count), unsafe.Pointer(value))
+}
+
+// ProgramUniform1i calls glProgramUniform1i (GL_ES_VERSION_3_1).
+func ProgramUniform1i(program uint32, location int32, v0 int32) {
+	C.callProgramUniform1i(C.uintptr_t(proc(procProgramUniform1i)), C.GLuint(program), C.GLint(location), C.GLint(v0))
+}
+
+// ProgramUniform1iv calls glProgramUniform1iv (GL_ES_VERSION_3_1).
+func ProgramUniform1iv(program uint32, location int32, count int32, value *int32) {
+	C.callProgramUniform1iv(C.uintptr_t(proc(procProgramUniform1iv)), C.GLuint(program), C.GLint(location), C.GLsizei(count), unsafe.Pointer(value))
+}
+
+// ProgramUniform1ui calls glProgramUniform1ui (GL_ES_VERSION_3_1).
+func ProgramUniform1ui(program uint32, location int32, v0 uint32) {
+	C.callProgramUniform1ui(C.uintptr_t(proc(procProgramUniform1ui)), C.GLuint(program), C.GLint(location), C.GLuint(v0))
+}
+
+// ProgramUniform1uiv calls glProgramUniform1uiv (GL_ES_VERSION_3_1).
+func ProgramUniform1uiv(program uint32, location int32, count int32, value *uint32) {
+	C.callProgramUniform1uiv(C.uintptr_t(proc(procProgramUniform1uiv)), C.GLuint(program), C.GLint(location), C.GLsizei(count), unsafe.Pointer(value))
+}
+
+// ProgramUniform2f calls glProgramUniform2f (GL_ES_VERSION_3_1).
+func ProgramUniform2f(program uint32, location int32, v0 float32, v1 float32) {
+	C.callProgramUniform2f(C.uintptr_t(proc(procProgramUniform2f)), C.GLuint(program), C.GLint(location), C.GLfloat(v0), C.GLfloat(v1))
+}
+
+// ProgramUniform2fv calls glProgramUniform2fv (GL_ES_VERSION_3_1).
+func ProgramUniform2fv(program uint32, location int32, count int32, value *float32) {
+	C.callProgramUniform2fv(C.uintptr_t(proc(procProgramUniform2fv)), C.GLuint(program), C.GLint(location), C.GLsizei(count), unsafe.Pointer(value))
+}
+
+// ProgramUniform2i calls glProgramUniform2i (GL_ES_VERSION_3_1).
+func ProgramUniform2i(program uint32, location int32, v0 int32, v1 int32) {
+	C.callProgramUniform2i(C.uintptr_t(proc(procProgramUniform2i)), C.GLuint(program), C.GLint(location), C.GLint(v0), C.GLint(v1))
+}
+
+// ProgramUniform2iv calls glProgramUniform2iv (GL_ES_VERSION_3_1).
+func ProgramUniform2iv(program uint32, location int32, count int32, value *int32) {
+	C.callProgramUniform2iv(C.uintptr_t(proc(procProgramUniform2iv)), C.GLuint(program), C.GLint(location), C.GLsizei(count), unsafe.Pointer(value))
+}
+
+// ProgramUniform2ui calls glProgramUniform2ui (GL_ES_VERSION_3_1).
+func ProgramUniform2ui(program uint32, location int32, v0 uint32, v1 uint32) {
+	C.callProgramUniform2ui(C.uintptr_t(proc(procProgramUniform2ui)), C.GLuint(program), C.GLint(location), C.GLuint(v0), C.GLuint(v1))
+}
+
+// ProgramUniform2uiv calls glProgramUniform2uiv (GL_ES_VERSION_3_1).
+func ProgramUniform2uiv(program uint32, location int32, count int32, value *uint32) {
+	C.callProgramUniform2uiv(C.uintptr_t(proc(procProgramUniform2uiv)), C.GLuint(program), C.GLint(location), C.GLsizei(count), unsafe.Pointer(value))
+}
+
+// ProgramUniform3f calls glProgramUniform3f (GL_ES_VERSION_3_1).
+func ProgramUniform3f(program uint32, location int32, v0 float32, v1 float32, v2 float32) {
+	C.callProgramUniform3f(C.uintptr_t(proc(procProgramUniform3f)), C.GLuint(program), C.GLint(location), C.GLfloat(v0), C.GLfloat(v1), C.GLfloat(v2))
+}
+
+// ProgramUniform3fv calls glProgramUniform3fv (GL_ES_VERSION_3_1).
+func ProgramUniform3fv(program uint32, location int32, count int32, value *float32) {
+	C.callProgramUniform3fv(C.uintptr_t(proc(procProgramUniform3fv)), C.GLuint(program), C.GLint(location), C.GLsizei(count), unsafe.Pointer(value))
+}
+
+// ProgramUniform3i calls glProgramUniform3i (GL_ES_VERSION_3_1).
+func ProgramUniform3i(program uint32, location int32, v0 int32, v1 int32, v2 int32) {
+	C.callProgramUniform3i(C.uintptr_t(proc(procProgramUniform3i)), C.GLuint(program), C.GLint(location), C.GLint(v0), C.GLint(v1), C.GLint(v2))
+}
+
+// ProgramUniform3iv calls glProgramUniform3iv (GL_ES_VERSION_3_1).
+func ProgramUniform3iv(program uint32, location int32, count int32, value *int32) {
+	C.callProgramUniform3iv(C.uintptr_t(proc(procProgramUniform3iv)), C.GLuint(program), C.GLint(location), C.GLsizei(count), unsafe.Pointer(value))
+}
+
+// ProgramUniform3ui calls glProgramUniform3ui (GL_ES_VERSION_3_1).
+func ProgramUniform3ui(program uint32, location int32, v0 uint32, v1 uint32, v2 uint32) {
+	C.callProgramUniform3ui(C.uintptr_t(proc(procProgramUniform3ui)), C.GLuint(program), C.GLint(location), C.GLuint(v0), C.GLuint(v1), C.GLuint(v2))
+}
+
+// ProgramUniform3uiv calls glProgramUniform3uiv (GL_ES_VERSION_3_1).
+func ProgramUniform3uiv(program uint32, location int32, count int32, value *uint32) {
+	C.callProgramUniform3uiv(C.uintptr_t(proc(procProgramUniform3uiv)), C.GLuint(program), C.GLint(location), C.GLsizei(count), unsafe.Pointer(value))
+}
+
+// ProgramUniform4f calls glProgramUniform4f (GL_ES_VERSION_3_1).
+func ProgramUniform4f(program uint32, location int32, v0 float32, v1 float32, v2 float32, v3 float32) {
+	C.callProgramUniform4f(C.uintptr_t(proc(procProgramUniform4f)), C.GLuint(program), C.GLint(location), C.GLfloat(v0), C.GLfloat(v1), C.GLfloat(v2), C.GLfloat(v3))
+}
+
+// ProgramUniform4fv calls glProgramUniform4fv (GL_ES_VERSION_3_1).
+func ProgramUniform4fv(program uint32, location int32, count int32, value *float32) {
+	C.callProgramUniform4fv(C.uintptr_t(proc(procProgramUniform4fv)), C.GLuint(program), C.GLint(location), C.GLsizei(count), unsafe.Pointer(value))
+}
+
+// ProgramUniform4i calls glProgramUniform4i (GL_ES_VERSION_3_1).
+func ProgramUniform4i(program uint32, location int32, v0 int32, v1 int32, v2 int32, v3 int32) {
+	C.callProgramUniform4i(C.uintptr_t(proc(procProgramUniform4i)), C.GLuint(program), C.GLint(location), C.GLint(v0), C.GLint(v1), C.GLint(v2), C.GLint(v3))
+}
+
+// ProgramUniform4iv calls glProgramUniform4iv (GL_ES_VERSION_3_1).
+func ProgramUniform4iv(program uint32, location int32, count int32, value *int32) {
+	C.callProgramUniform4iv(C.uintptr_t(proc(procProgramUniform4iv)), C.GLuint(program), C.GLint(location), C.GLsizei(count), unsafe.Pointer(value))
+}
+
+// ProgramUniform4ui calls glProgramUniform4ui (GL_ES_VERSION_3_1).
+func ProgramUniform4ui(program uint32, location int32, v0 uint32, v1 uint32, v2 uint32, v3 uint32) {
+	C.callProgramUniform4ui(C.uintptr_t(proc(procProgramUniform4ui)), C.GLuint(program), C.GLint(location), C.GLuint(v0), C.GLuint(v1), C.GLuint(v2), C.GLuint(v3))
+}
+
+// ProgramUniform4uiv calls glProgramUniform4uiv (GL_ES_VERSION_3_1).
+func ProgramUniform4uiv(program uint32, location int32, count int32, value *uint32) {
+	C.callProgramUniform4uiv(C.uintptr_t(proc(procProgramUniform4uiv)), C.GLuint(program), C.GLint(location), C.GLsizei(count), unsafe.Pointer(value))
+}
+
+// ProgramUniformMatrix2fv calls glProgramUniformMatrix2fv (GL_ES_VERSION_3_1).
+func ProgramUniformMatrix2fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	C.callProgramUniformMatrix2fv(C.uintptr_t(proc(procProgramUniformMatrix2fv)), C.GLuint(program), C.GLint(location), C.GLsizei(count), cbool(transpose), unsafe.Pointer(value))
+}
+
+// ProgramUniformMatrix2x3fv calls glProgramUniformMatrix2x3fv (GL_ES_VERSION_3_1).
+func ProgramUniformMatrix2x3fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	C.callProgramUniformMatrix2x3fv(C.uintptr_t(proc(procProgramUniformMatrix2x3fv)), C.GLuint(program), C.GLint(location), C.GLsizei(count), cbool(transpose), unsafe.Pointer(value))
+}
+
+// ProgramUniformMatrix2x4fv calls glProgramUniformMatrix2x4fv (GL_ES_VERSION_3_1).
+func ProgramUniformMatrix2x4fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	C.callProgramUniformMatrix2x4fv(C.uintptr_t(proc(procProgramUniformMatrix2x4fv)), C.GLuint(program), C.GLint(location), C.GLsizei(count), cbool(transpose), unsafe.Pointer(value))
+}
+
+// ProgramUniformMatrix3fv calls glProgramUniformMatrix3fv (GL_ES_VERSION_3_1).
+func ProgramUniformMatrix3fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	C.callProgramUniformMatrix3fv(C.uintptr_t(proc(procProgramUniformMatrix3fv)), C.GLuint(program), C.GLint(location), C.GLsizei(count), cbool(transpose), unsafe.Pointer(value))
+}
+
+// ProgramUniformMatrix3x2fv calls glProgramUniformMatrix3x2fv (GL_ES_VERSION_3_1).
+func ProgramUniformMatrix3x2fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	C.callProgramUniformMatrix3x2fv(C.uintptr_t(proc(procProgramUniformMatrix3x2fv)), C.GLuint(program), C.GLint(location), C.GLsizei(count), cbool(transpose), unsafe.Pointer(value))
+}
+
+// ProgramUniformMatrix3x4fv calls glProgramUniformMatrix3x4fv (GL_ES_VERSION_3_1).
+func ProgramUniformMatrix3x4fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	C.callProgramUniformMatrix3x4fv(C.uintptr_t(proc(procProgramUniformMatrix3x4fv)), C.GLuint(program), C.GLint(location), C.GLsizei(count), cbool(transpose), unsafe.Pointer(value))
+}
+
+// ProgramUniformMatrix4fv calls glProgramUniformMatrix4fv (GL_ES_VERSION_3_1).
+func ProgramUniformMatrix4fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	C.callProgramUniformMatrix4fv(C.uintptr_t(proc(procProgramUniformMatrix4fv)), C.GLuint(program), C.GLint(location), C.GLsizei(count), cbool(transpose), unsafe.Pointer(value))
+}
+
+// ProgramUniformMatrix4x2fv calls glProgramUniformMatrix4x2fv (GL_ES_VERSION_3_1).
+func ProgramUniformMatrix4x2fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	C.callProgramUniformMatrix4x2fv(C.uintptr_t(proc(procProgramUniformMatrix4x2fv)), C.GLuint(program), C.GLint(location), C.GLsizei(count), cbool(transpose), unsafe.Pointer(value))
+}
+
+// ProgramUniformMatrix4x3fv calls glProgramUniformMatrix4x3fv (GL_ES_VERSION_3_1).
+func ProgramUniformMatrix4x3fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	C.callProgramUniformMatrix4x3fv(C.uintptr_t(proc(procProgramUniformMatrix4x3fv)), C.GLuint(program), C.GLint(location), C.GLsizei(count), cbool(transpose), unsafe.Pointer(value))
+}
+
+// PushDebugGroup calls glPushDebugGroup or glPushDebugGroupKHR (GL_ES_VERSION_3_2).
+func PushDebugGroup(source Enum, id uint32, length int32, message string) {
+	cs3 := C.CString(message)
+	defer C.free(unsafe.Pointer(cs3))
+	C.callPushDebugGroup(C.uintptr_t(proc(procPushDebugGroup)), C.GLenum(source), C.GLuint(id), C.GLsizei(length), unsafe.Pointer(cs3))
+}
+
+// ReadBuffer calls glReadBuffer (GL_ES_VERSION_3_0).
+func ReadBuffer(src Enum) {
+	C.callReadBuffer(C.uintptr_t(proc(procReadBuffer)), C.GLenum(src))
+}
+
+// ReadPixels calls glReadPixels (GL_ES_VERSION_2_0).
+func ReadPixels(x int32, y int32, width int32, height int32, format Enum, typ Enum, pixels unsafe.Pointer) {
+	C.callReadPixels(C.uintptr_t(proc(procReadPixels)), C.GLint(x), C.GLint(y), C.GLsizei(width), C.GLsizei(height), C.GLenum(format), C.GLenum(typ), pixels)
+}
+
+// ReadnPixels calls glReadnPixels (GL_ES_VERSION_3_2).
+func ReadnPixels(x int32, y int32, width int32, height int32, format Enum, typ Enum, bufSize int32, data unsafe.Pointer) {
+	C.callReadnPixels(C.uintptr_t(proc(procReadnPixels)), C.GLint(x), C.GLint(y), C.GLsizei(width), C.GLsizei(height), C.GLenum(format), C.GLenum(typ), C.GLsizei(bufSize), data)
+}
+
+// ReleaseShaderCompiler calls glReleaseShaderCompiler (GL_ES_VERSION_2_0).
+func ReleaseShaderCompiler() {
+	C.callReleaseShaderCompiler(C.uintptr_t(proc(procReleaseShaderCompiler)))
+}
+
+// RenderbufferStorage calls glRenderbufferStorage (GL_ES_VERSION_2_0).
+func RenderbufferStorage(target Enum, internalformat Enum, width int32, height int32) {
+	C.callRenderbufferStorage(C.uintptr_t(proc(procRenderbufferStorage)), C.GLenum(target), C.GLenum(internalformat), C.GLsizei(width), C.GLsizei(height))
+}
+
+// RenderbufferStorageMultisample calls glRenderbufferStorageMultisample (GL_ES_VERSION_3_0).
+func RenderbufferStorageMultisample(target Enum, samples int32, internalformat Enum, width int32, height int32) {
+	C.callRenderbufferStorageMultisample(C.uintptr_t(proc(procRenderbufferStorageMultisample)), C.GLenum(target), C.GLsizei(samples), C.GLenum(internalformat), C.GLsizei(width), C.GLsizei(height))
+}
+
+// ResumeTransformFeedback calls glResumeTransformFeedback (GL_ES_VERSION_3_0).
+func ResumeTransformFeedback() {
+	C.callResumeTransformFeedback(C.uintptr_t(proc(procResumeTransformFeedback)))
+}
+
+// SampleCoverage calls glSampleCoverage (GL_ES_VERSION_2_0).
+func SampleCoverage(value float32, invert bool) {
+	C.callSampleCoverage(C.uintptr_t(proc(procSampleCoverage)), C.GLfloat(value), cbool(invert))
+}
+
+// SampleMaski calls glSampleMaski (GL_ES_VERSION_3_1).
+func SampleMaski(maskNumber uint32, mask uint32) {
+	C.callSampleMaski(C.uintptr_t(proc(procSampleMaski)), C.GLuint(maskNumber), C.GLbitfield(mask))
+}
+
+// SamplerParameterIiv calls glSamplerParameterIiv (GL_ES_VERSION_3_2).
+func SamplerParameterIiv(sampler uint32, pname Enum, param *int32) {
+	C.callSamplerParameterIiv(C.uintptr_t(proc(procSamplerParameterIiv)), C.GLuint(sampler), C.GLenum(pname), unsafe.Pointer(param))
+}
+
+// SamplerParameterIuiv calls glSamplerParameterIuiv (GL_ES_VERSION_3_2).
+func SamplerParameterIuiv(sampler uint32, pname Enum, param *uint32) {
+	C.callSamplerParameterIuiv(C.uintptr_t(proc(procSamplerParameterIuiv)), C.GLuint(sampler), C.GLenum(pname), unsafe.Pointer(param))
+}
+
+// SamplerParameterf calls glSamplerParameterf (GL_ES_VERSION_3_0).
+func SamplerParameterf(sampler uint32, pname Enum, param float32) {
+	C.callSamplerParameterf(C.uintptr_t(proc(procSamplerParameterf)), C.GLuint(sampler), C.GLenum(pname), C.GLfloat(param))
+}
+
+// SamplerParameterfv calls glSamplerParameterfv (GL_ES_VERSION_3_0).
+func SamplerParameterfv(sampler uint32, pname Enum, param *float32) {
+	C.callSamplerParameterfv(C.uintptr_t(proc(procSamplerParameterfv)), C.GLuint(sampler), C.GLenum(pname), unsafe.Pointer(param))
+}
+
+// SamplerParameteri calls glSamplerParameteri (GL_ES_VERSION_3_0).
+func SamplerParameteri(sampler uint32, pname Enum, param int32) {
+	C.callSamplerParameteri(C.uintptr_t(proc(procSamplerParameteri)), C.GLuint(sampler), C.GLenum(pname), C.GLint(param))
+}
+
+// SamplerParameteriv calls glSamplerParameteriv (GL_ES_VERSION_3_0).
+func SamplerParameteriv(sampler uint32, pname Enum, param *int32) {
+	C.callSamplerParameteriv(C.uintptr_t(proc(procSamplerParameteriv)), C.GLuint(sampler), C.GLenum(pname), unsafe.Pointer(param))
+}
+
+// Scissor calls glScissor (GL_ES_VERSION_2_0).
+func Scissor(x int32, y int32, width int32, height int32) {
+	C.callScissor(C.uintptr_t(proc(procScissor)), C.GLint(x), C.GLint(y), C.GLsizei(width), C.GLsizei(height))
+}
+
+// ShaderBinary calls glShaderBinary (GL_ES_VERSION_2_0).
+func ShaderBinary(count int32, shaders *uint32, binaryformat Enum, binary unsafe.Pointer, length int32) {
+	C.callShaderBinary(C.uintptr_t(proc(procShaderBinary)), C.GLsizei(count), unsafe.Pointer(shaders), C.GLenum(binaryformat), binary, C.GLsizei(length))
+}
+
+// ShaderSource calls glShaderSource (GL_ES_VERSION_2_0).
+func ShaderSource(shader uint32, count int32, str **uint8, length *int32) {
+	C.callShaderSource(C.uintptr_t(proc(procShaderSource)), C.GLuint(shader), C.GLsizei(count), unsafe.Pointer(str), unsafe.Pointer(length))
+}
+
+// StencilFunc calls glStencilFunc (GL_ES_VERSION_2_0).
+func StencilFunc(fn Enum, ref int32, mask uint32) {
+	C.callStencilFunc(C.uintptr_t(proc(procStencilFunc)), C.GLenum(fn), C.GLint(ref), C.GLuint(mask))
+}
+
+// StencilFuncSeparate calls glStencilFuncSeparate (GL_ES_VERSION_2_0).
+func StencilFuncSeparate(face Enum, fn Enum, ref int32, mask uint32) {
+	C.callStencilFuncSeparate(C.uintptr_t(proc(procStencilFuncSeparate)), C.GLenum(face), C.GLenum(fn), C.GLint(ref), C.GLuint(mask))
+}
+
+// StencilMask calls glStencilMask (GL_ES_VERSION_2_0).
+func StencilMask(mask uint32) {
+	C.callStencilMask(C.uintptr_t(proc(procStencilMask)), C.GLuint(mask))
+}
+
+// StencilMaskSeparate calls glStencilMaskSeparate (GL_ES_VERSION_2_0).
+func StencilMaskSeparate(face Enum, mask uint32) {
+	C.callStencilMaskSeparate(C.uintptr_t(proc(procStencilMaskSeparate)), C.GLenum(face), C.GLuint(mask))
+}
+
+// StencilOp calls glStencilOp (GL_ES_VERSION_2_0).
+func StencilOp(fail Enum, zfail Enum, zpass Enum) {
+	C.callStencilOp(C.uintptr_t(proc(procStencilOp)), C.GLenum(fail), C.GLenum(zfail), C.GLenum(zpass))
+}
+
+// StencilOpSeparate calls glStencilOpSeparate (GL_ES_VERSION_2_0).
+func StencilOpSeparate(face Enum, sfail Enum, dpfail Enum, dppass Enum) {
+	C.callStencilOpSeparate(C.uintptr_t(proc(procStencilOpSeparate)), C.GLenum(face), C.GLenum(sfail), C.GLenum(dpfail), C.GLenum(dppass))
+}
+
+// TexBuffer calls glTexBuffer (GL_ES_VERSION_3_2).
+func TexBuffer(target Enum, internalformat Enum, buffer uint32) {
+	C.callTexBuffer(C.uintptr_t(proc(procTexBuffer)), C.GLenum(target), C.GLenum(internalformat), C.GLuint(buffer))
+}
+
+// TexBufferRange calls glTexBufferRange (GL_ES_VERSION_3_2).
+func TexBufferRange(target Enum, internalformat Enum, buffer uint32, offset int, size int) {
+	C.callTexBufferRange(C.uintptr_t(proc(procTexBufferRange)), C.GLenum(target), C.GLenum(internalformat), C.GLuint(buffer), C.GLintptr(offset), C.GLsizeiptr(size))
+}
+
+// TexImage2D calls glTexImage2D (GL_ES_VERSION_2_0).
+func TexImage2D(target Enum, level int32, internalformat int32, width int32, height int32, border int32, format Enum, typ Enum, pixels unsafe.Pointer) {
+	C.callTexImage2D(C.uintptr_t(proc(procTexImage2D)), C.GLenum(target), C.GLint(level), C.GLint(internalformat), C.GLsizei(width), C.GLsizei(height), C.GLint(border), C.GLenum(format), C.GLenum(typ), pixels)
+}
+
+// TexImage3D calls glTexImage3D (GL_ES_VERSION_3_0).
+func TexImage3D(target Enum, level int32, internalformat int32, width int32, height int32, depth int32, border int32, format Enum, typ Enum, pixels unsafe.Pointer) {
+	C.callTexImage3D(C.uintptr_t(proc(procTexImage3D)), C.GLenum(target), C.GLint(level), C.GLint(internalformat), C.GLsizei(width), C.GLsizei(height), C.GLsizei(depth), C.GLint(border), C.GLenum(format), C.GLenum(typ), pixels)
+}
+
+// TexParameterIiv calls glTexParameterIiv (GL_ES_VERSION_3_2).
+func TexParameterIiv(target Enum, pname Enum, params *int32) {
+	C.callTexParameterIiv(C.uintptr_t(proc(procTexParameterIiv)), C.GLenum(target), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// TexParameterIuiv calls glTexParameterIuiv (GL_ES_VERSION_3_2).
+func TexParameterIuiv(target Enum, pname Enum, params *uint32) {
+	C.callTexParameterIuiv(C.uintptr_t(proc(procTexParameterIuiv)), C.GLenum(target), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// TexParameterf calls glTexParameterf (GL_ES_VERSION_2_0).
+func TexParameterf(target Enum, pname Enum, param float32) {
+	C.callTexParameterf(C.uintptr_t(proc(procTexParameterf)), C.GLenum(target), C.GLenum(pname), C.GLfloat(param))
+}
+
+// TexParameterfv calls glTexParameterfv (GL_ES_VERSION_2_0).
+func TexParameterfv(target Enum, pname Enum, params *float32) {
+	C.callTexParameterfv(C.uintptr_t(proc(procTexParameterfv)), C.GLenum(target), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// TexParameteri calls glTexParameteri (GL_ES_VERSION_2_0).
+func TexParameteri(target Enum, pname Enum, param int32) {
+	C.callTexParameteri(C.uintptr_t(proc(procTexParameteri)), C.GLenum(target), C.GLenum(pname), C.GLint(param))
+}
+
+// TexParameteriv calls glTexParameteriv (GL_ES_VERSION_2_0).
+func TexParameteriv(target Enum, pname Enum, params *int32) {
+	C.callTexParameteriv(C.uintptr_t(proc(procTexParameteriv)), C.GLenum(target), C.GLenum(pname), unsafe.Pointer(params))
+}
+
+// TexStorage2D calls glTexStorage2D (GL_ES_VERSION_3_0).
+func TexStorage2D(target Enum, levels int32, internalformat Enum, width int32, height int32) {
+	C.callTexStorage2D(C.uintptr_t(proc(procTexStorage2D)), C.GLenum(target), C.GLsizei(levels), C.GLenum(internalformat), C.GLsizei(width), C.GLsizei(height))
+}
+
+// TexStorage2DMultisample calls glTexStorage2DMultisample (GL_ES_VERSION_3_1).
+func TexStorage2DMultisample(target Enum, samples int32, internalformat Enum, width int32, height int32, fixedsamplelocations bool) {
+	C.callTexStorage2DMultisample(C.uintptr_t(proc(procTexStorage2DMultisample)), C.GLenum(target), C.GLsizei(samples), C.GLenum(internalformat), C.GLsizei(width), C.GLsizei(height), cbool(fixedsamplelocations))
+}
+
+// TexStorage3D calls glTexStorage3D (GL_ES_VERSION_3_0).
+func TexStorage3D(target Enum, levels int32, internalformat Enum, width int32, height int32, depth int32) {
+	C.callTexStorage3D(C.uintptr_t(proc(procTexStorage3D)), C.GLenum(target), C.GLsizei(levels), C.GLenum(internalformat), C.GLsizei(width), C.GLsizei(height), C.GLsizei(depth))
+}
+
+// TexStorage3DMultisample calls glTexStorage3DMultisample (GL_ES_VERSION_3_2).
+func TexStorage3DMultisample(target Enum, samples int32, internalformat Enum, width int32, height int32, depth int32, fixedsamplelocations bool) {
+	C.callTexStorage3DMultisample(C.uintptr_t(proc(procTexStorage3DMultisample)), C.GLenum(target), C.GLsizei(samples), C.GLenum(internalformat), C.GLsizei(width), C.GLsizei(height), C.GLsizei(depth), cbool(fixedsamplelocations))
+}
+
+// TexSubImage2D calls glTexSubImage2D (GL_ES_VERSION_2_0).
+func TexSubImage2D(target Enum, level int32, xoffset int32, yoffset int32, width int32, height int32, format Enum, typ Enum, pixels unsafe.Pointer) {
+	C.callTexSubImage2D(C.uintptr_t(proc(procTexSubImage2D)), C.GLenum(target), C.GLint(level), C.GLint(xoffset), C.GLint(yoffset), C.GLsizei(width), C.GLsizei(height), C.GLenum(format), C.GLenum(typ), pixels)
+}
+
+// TexSubImage3D calls glTexSubImage3D (GL_ES_VERSION_3_0).
+func TexSubImage3D(target Enum, level int32, xoffset int32, yoffset int32, zoffset int32, width int32, height int32, depth int32, format Enum, typ Enum, pixels unsafe.Pointer) {
+	C.callTexSubImage3D(C.uintptr_t(proc(procTexSubImage3D)), C.GLenum(target), C.GLint(level), C.GLint(xoffset), C.GLint(yoffset), C.GLint(zoffset), C.GLsizei(width), C.GLsizei(height), C.GLsizei(depth), C.GLenum(format), C.GLenum(typ), pixels)
+}
+
+// TransformFeedbackVaryings calls glTransformFeedbackVaryings (GL_ES_VERSION_3_0).
+func TransformFeedbackVaryings(program uint32, count int32, varyings **uint8, bufferMode Enum) {
+	C.callTransformFeedbackVaryings(C.uintptr_t(proc(procTransformFeedbackVaryings)), C.GLuint(program), C.GLsizei(count), unsafe.Pointer(varyings), C.GLenum(bufferMode))
+}
+
+// Uniform1f calls glUniform1f (GL_ES_VERSION_2_0).
+func Uniform1f(location int32, v0 float32) {
+	C.callUniform1f(C.uintptr_t(proc(procUniform1f)), C.GLint(location), C.GLfloat(v0))
+}
+
+// Uniform1fv calls glUniform1fv (GL_ES_VERSION_2_0).
+func Uniform1fv(location int32, count int32, value *float32) {
+	C.callUniform1fv(C.uintptr_t(proc(procUniform1fv)), C.GLint(location), C.GLsizei(count), unsafe.Pointer(value))
+}
+
+// Uniform1i calls glUniform1i (GL_ES_VERSION_2_0).
+func Uniform1i(location int32, v0 int32) {
+	C.callUniform1i(C.uintptr_t(proc(procUniform1i)), C.GLint(location), C.GLint(v0))
+}
+
+// Uniform1iv calls glUniform1iv (GL_ES_VERSION_2_0).
+func Uniform1iv(location int32, count int32, value *int32) {
+	C.callUniform1iv(C.uintptr_t(proc(procUniform1iv)), C.GLint(location), C.GLsizei(count), unsafe.Pointer(value))
+}
+
+// Uniform1ui calls glUniform1ui (GL_ES_VERSION_3_0).
+func Uniform1ui(location int32, v0 uint32) {
+	C.callUniform1ui(C.uintptr_t(proc(procUniform1ui)), C.GLint(location), C.GLuint(v0))
+}
+
+// Uniform1uiv calls glUniform1uiv (GL_ES_VERSION_3_0).
+func Uniform1uiv(location int32, count int32, value *uint32) {
+	C.callUniform1uiv(C.uintptr_t(proc(procUniform1uiv)), C.GLint(location), C.GLsizei(count), unsafe.Pointer(value))
+}
+
+// Uniform2f calls glUniform2f (GL_ES_VERSION_2_0).
+func Uniform2f(location int32, v0 float32, v1 float32) {
+	C.callUniform2f(C.uintptr_t(proc(procUniform2f)), C.GLint(location), C.GLfloat(v0), C.GLfloat(v1))
+}
+
+// Uniform2fv calls glUniform2fv (GL_ES_VERSION_2_0).
+func Uniform2fv(location int32, count int32, value *float32) {
+	C.callUniform2fv(C.uintptr_t(proc(procUniform2fv)), C.GLint(location), C.GLsizei(count), unsafe.Pointer(value))
+}
+
+// Uniform2i calls glUniform2i (GL_ES_VERSION_2_0).
+func Uniform2i(location int32, v0 int32, v1 int32) {
+	C.callUniform2i(C.uintptr_t(proc(procUniform2i)), C.GLint(location), C.GLint(v0), C.GLint(v1))
+}
+
+// Uniform2iv calls glUniform2iv (GL_ES_VERSION_2_0).
+func Uniform2iv(location int32, count int32, value *int32) {
+	C.callUniform2iv(C.uintptr_t(proc(procUniform2iv)), C.GLint(location), C.GLsizei(count), unsafe.Pointer(value))
+}
+
+// Uniform2ui calls glUniform2ui (GL_ES_VERSION_3_0).
+func Uniform2ui(location int32, v0 uint32, v1 uint32) {
+	C.callUniform2ui(C.uintptr_t(proc(procUniform2ui)), C.GLint(location), C.GLuint(v0), C.GLuint(v1))
+}
+
+// Uniform2uiv calls glUniform2uiv (GL_ES_VERSION_3_0).
+func Uniform2uiv(location int32, count int32, value *uint32) {
+	C.callUniform2uiv(C.uintptr_t(proc(procUniform2uiv)), C.GLint(location), C.GLsizei(count), unsafe.Pointer(value))
+}
+
+// Uniform3f calls glUniform3f (GL_ES_VERSION_2_0).
+func Uniform3f(location int32, v0 float32, v1 float32, v2 float32) {
+	C.callUniform3f(C.uintptr_t(proc(procUniform3f)), C.GLint(location), C.GLfloat(v0), C.GLfloat(v1), C.GLfloat(v2))
+}
+
+// Uniform3fv calls glUniform3fv (GL_ES_VERSION_2_0).
+func Uniform3fv(location int32, count int32, value *float32) {
+	C.callUniform3fv(C.uintptr_t(proc(procUniform3fv)), C.GLint(location), C.GLsizei(count), unsafe.Pointer(value))
+}
+
+// Uniform3i calls glUniform3i (GL_ES_VERSION_2_0).
+func Uniform3i(location int32, v0 int32, v1 int32, v2 int32) {
+	C.callUniform3i(C.uintptr_t(proc(procUniform3i)), C.GLint(location), C.GLint(v0), C.GLint(v1), C.GLint(v2))
+}
+
+// Uniform3iv calls glUniform3iv (GL_ES_VERSION_2_0).
+func Uniform3iv(location int32, count int32, value *int32) {
+	C.callUniform3iv(C.uintptr_t(proc(procUniform3iv)), C.GLint(location), C.GLsizei(count), unsafe.Pointer(value))
+}
+
+// Uniform3ui calls glUniform3ui (GL_ES_VERSION_3_0).
+func Uniform3ui(location int32, v0 uint32, v1 uint32, v2 uint32) {
+	C.callUniform3ui(C.uintptr_t(proc(procUniform3ui)), C.GLint(location), C.GLuint(v0), C.GLuint(v1), C.GLuint(v2))
+}
+
+// Uniform3uiv calls glUniform3uiv (GL_ES_VERSION_3_0).
+func Uniform3uiv(location int32, count int32, value *uint32) {
+	C.callUniform3uiv(C.uintptr_t(proc(procUniform3uiv)), C.GLint(location), C.GLsizei(count), unsafe.Pointer(value))
+}
+
+// Uniform4f calls glUniform4f (GL_ES_VERSION_2_0).
+func Uniform4f(location int32, v0 float32, v1 float32, v2 float32, v3 float32) {
+	C.callUniform4f(C.uintptr_t(proc(procUniform4f)), C.GLint(location), C.GLfloat(v0), C.GLfloat(v1), C.GLfloat(v2), C.GLfloat(v3))
+}
+
+// Uniform4fv calls glUniform4fv (GL_ES_VERSION_2_0).
+func Uniform4fv(location int32, count int32, value *float32) {
+	C.callUniform4fv(C.uintptr_t(proc(procUniform4fv)), C.GLint(location), C.GLsizei(count), unsafe.Pointer(value))
+}
+
+// Uniform4i calls glUniform4i (GL_ES_VERSION_2_0).
+func Uniform4i(location int32, v0 int32, v1 int32, v2 int32, v3 int32) {
+	C.callUniform4i(C.uintptr_t(proc(procUniform4i)), C.GLint(location), C.GLint(v0), C.GLint(v1), C.GLint(v2), C.GLint(v3))
+}
+
+// Uniform4iv calls glUniform4iv (GL_ES_VERSION_2_0).
+func Uniform4iv(location int32, count int32, value *int32) {
+	C.callUniform4iv(C.uintptr_t(proc(procUniform4iv)), C.GLint(location), C.GLsizei(count), unsafe.Pointer(value))
+}
+
+// Uniform4ui calls glUniform4ui (GL_ES_VERSION_3_0).
+func Uniform4ui(location int32, v0 uint32, v1 uint32, v2 uint32, v3 uint32) {
+	C.callUniform4ui(C.uintptr_t(proc(procUniform4ui)), C.GLint(location), C.GLuint(v0), C.GLuint(v1), C.GLuint(v2), C.GLuint(v3))
+}
+
+// Uniform4uiv calls glUniform4uiv (GL_ES_VERSION_3_0).
+func Uniform4uiv(location int32, count int32, value *uint32) {
+	C.callUniform4uiv(C.uintptr_t(proc(procUniform4uiv)), C.GLint(location), C.GLsizei(count), unsafe.Pointer(value))
+}
+
+// UniformBlockBinding calls glUniformBlockBinding (GL_ES_VERSION_3_0).
+func UniformBlockBinding(program uint32, uniformBlockIndex uint32, uniformBlockBinding uint32) {
+	C.callUniformBlockBinding(C.uintptr_t(proc(procUniformBlockBinding)), C.GLuint(program), C.GLuint(uniformBlockIndex), C.GLuint(uniformBlockBinding))
+}
+
+// UniformMatrix2fv calls glUniformMatrix2fv (GL_ES_VERSION_2_0).
+func UniformMatrix2fv(location int32, count int32, transpose bool, value *float32) {
+	C.callUniformMatrix2fv(C.uintptr_t(proc(procUniformMatrix2fv)), C.GLint(location), C.GLsizei(count), cbool(transpose), unsafe.Pointer(value))
+}
+
+// UniformMatrix2x3fv calls glUniformMatrix2x3fv (GL_ES_VERSION_3_0).
+func UniformMatrix2x3fv(location int32, count int32, transpose bool, value *float32) {
+	C.callUniformMatrix2x3fv(C.uintptr_t(proc(procUniformMatrix2x3fv)), C.GLint(location), C.GLsizei(count), cbool(transpose), unsafe.Pointer(value))
+}
+
+// UniformMatrix2x4fv calls glUniformMatrix2x4fv (GL_ES_VERSION_3_0).
+func UniformMatrix2x4fv(location int32, count int32, transpose bool, value *float32) {
+	C.callUniformMatrix2x4fv(C.uintptr_t(proc(procUniformMatrix2x4fv)), C.GLint(location), C.GLsizei(count), cbool(transpose), unsafe.Pointer(value))
+}
+
+// UniformMatrix3fv calls glUniformMatrix3fv (GL_ES_VERSION_2_0).
+func UniformMatrix3fv(location int32, count int32, transpose bool, value *float32) {
+	C.callUniformMatrix3fv(C.uintptr_t(proc(procUniformMatrix3fv)), C.GLint(location), C.GLsizei(count), cbool(transpose), unsafe.Pointer(value))
+}
+
+// UniformMatrix3x2fv calls glUniformMatrix3x2fv (GL_ES_VERSION_3_0).
+func UniformMatrix3x2fv(location int32, count int32, transpose bool, value *float32) {
+	C.callUniformMatrix3x2fv(C.uintptr_t(proc(procUniformMatrix3x2fv)), C.GLint(location), C.GLsizei(count), cbool(transpose), unsafe.Pointer(value))
+}
+
+// UniformMatrix3x4fv calls glUniformMatrix3x4fv (GL_ES_VERSION_3_0).
+func UniformMatrix3x4fv(location int32, count int32, transpose bool, value *float32) {
+	C.callUniformMatrix3x4fv(C.uintptr_t(proc(procUniformMatrix3x4fv)), C.GLint(location), C.GLsizei(count), cbool(transpose), unsafe.Pointer(value))
+}
+
+// UniformMatrix4fv calls glUniformMatrix4fv (GL_ES_VERSION_2_0).
+func UniformMatrix4fv(location int32, count int32, transpose bool, value *float32) {
+	C.callUniformMatrix4fv(C.uintptr_t(proc(procUniformMatrix4fv)), C.GLint(location), C.GLsizei(count), cbool(transpose), unsafe.Pointer(value))
+}
+
+// UniformMatrix4x2fv calls glUniformMatrix4x2fv (GL_ES_VERSION_3_0).
+func UniformMatrix4x2fv(location int32, count int32, transpose bool, value *float32) {
+	C.callUniformMatrix4x2fv(C.uintptr_t(proc(procUniformMatrix4x2fv)), C.GLint(location), C.GLsizei(count), cbool(transpose), unsafe.Pointer(value))
+}
+
+// UniformMatrix4x3fv calls glUniformMatrix4x3fv (GL_ES_VERSION_3_0).
+func UniformMatrix4x3fv(location int32, count int32, transpose bool, value *float32) {
+	C.callUniformMatrix4x3fv(C.uintptr_t(proc(procUniformMatrix4x3fv)), C.GLint(location), C.GLsizei(count), cbool(transpose), unsafe.Pointer(value))
+}
+
+// UnmapBuffer calls glUnmapBuffer (GL_ES_VERSION_3_0).
+func UnmapBuffer(target Enum) bool {
+	return C.callUnmapBuffer(C.uintptr_t(proc(procUnmapBuffer)), C.GLenum(target)) != 0
+}
+
+// UseProgram calls glUseProgram (GL_ES_VERSION_2_0).
+func UseProgram(program uint32) {
+	C.callUseProgram(C.uintptr_t(proc(procUseProgram)), C.GLuint(program))
+}
+
+// UseProgramStages calls glUseProgramStages (GL_ES_VERSION_3_1).
+func UseProgramStages(pipeline uint32, stages uint32, program uint32) {
+	C.callUseProgramStages(C.uintptr_t(proc(procUseProgramStages)), C.GLuint(pipeline), C.GLbitfield(stages), C.GLuint(program))
+}
+
+// ValidateProgram calls glValidateProgram (GL_ES_VERSION_2_0).
+func ValidateProgram(program uint32) {
+	C.callValidateProgram(C.uintptr_t(proc(procValidateProgram)), C.GLuint(program))
+}
+
+// ValidateProgramPipeline calls glValidateProgramPipeline (GL_ES_VERSION_3_1).
+func ValidateProgramPipeline(pipeline uint32) {
+	C.callValidateProgramPipeline(C.uintptr_t(proc(procValidateProgramPipeline)), C.GLuint(pipeline))
+}
+
+// VertexAttrib1f calls glVertexAttrib1f (GL_ES_VERSION_2_0).
+func VertexAttrib1f(index uint32, x float32) {
+	C.callVertexAttrib1f(C.uintptr_t(proc(procVertexAttrib1f)), C.GLuint(index), C.GLfloat(x))
+}
+
+// VertexAttrib1fv calls glVertexAttrib1fv (GL_ES_VERSION_2_0).
+func VertexAttrib1fv(index uint32, v *float32) {
+	C.callVertexAttrib1fv(C.uintptr_t(proc(procVertexAttrib1fv)), C.GLuint(index), unsafe.Pointer(v))
+}
+
+// VertexAttrib2f calls glVertexAttrib2f (GL_ES_VERSION_2_0).
+func VertexAttrib2f(index uint32, x float32, y float32) {
+	C.callVertexAttrib2f(C.uintptr_t(proc(procVertexAttrib2f)), C.GLuint(index), C.GLfloat(x), C.GLfloat(y))
+}
+
+// VertexAttrib2fv calls glVertexAttrib2fv (GL_ES_VERSION_2_0).
+func VertexAttrib2fv(index uint32, v *float32) {
+	C.callVertexAttrib2fv(C.uintptr_t(proc(procVertexAttrib2fv)), C.GLuint(index), unsafe.Pointer(v))
+}
+
+// VertexAttrib3f calls glVertexAttrib3f (GL_ES_VERSION_2_0).
+func VertexAttrib3f(index uint32, x float32, y float32, z float32) {
+	C.callVertexAttrib3f(C.uintptr_t(proc(procVertexAttrib3f)), C.GLuint(index), C.GLfloat(x), C.GLfloat(y), C.GLfloat(z))
+}
+
+// VertexAttrib3fv calls glVertexAttrib3fv (GL_ES_VERSION_2_0).
+func VertexAttrib3fv(index uint32, v *float32) {
+	C.callVertexAttrib3fv(C.uintptr_t(proc(procVertexAttrib3fv)), C.GLuint(index), unsafe.Pointer(v))
+}
+
+// VertexAttrib4f calls glVertexAttrib4f (GL_ES_VERSION_2_0).
+func VertexAttrib4f(index uint32, x float32, y float32, z float32, w float32) {
+	C.callVertexAttrib4f(C.uintptr_t(proc(procVertexAttrib4f)), C.GLuint(index), C.GLfloat(x), C.GLfloat(y), C.GLfloat(z), C.GLfloat(w))
+}
+
+// VertexAttrib4fv calls glVertexAttrib4fv (GL_ES_VERSION_2_0).
+func VertexAttrib4fv(index uint32, v *float32) {
+	C.callVertexAttrib4fv(C.uintptr_t(proc(procVertexAttrib4fv)), C.GLuint(index), unsafe.Pointer(v))
+}
+
+// VertexAttribBinding calls glVertexAttribBinding (GL_ES_VERSION_3_1).
+func VertexAttribBinding(attribindex uint32, bindingindex uint32) {
+	C.callVertexAttribBinding(C.uintptr_t(proc(procVertexAttribBinding)), C.GLuint(attribindex), C.GLuint(bindingindex))
+}
+
+// VertexAttribDivisor calls glVertexAttribDivisor (GL_ES_VERSION_3_0).
+func VertexAttribDivisor(index uint32, divisor uint32) {
+	C.callVertexAttribDivisor(C.uintptr_t(proc(procVertexAttribDivisor)), C.GLuint(index), C.GLuint(divisor))
+}
+
+// VertexAttribFormat calls glVertexAttribFormat (GL_ES_VERSION_3_1).
+func VertexAttribFormat(attribindex uint32, size int32, typ Enum, normalized bool, relativeoffset uint32) {
+	C.callVertexAttribFormat(C.uintptr_t(proc(procVertexAttribFormat)), C.GLuint(attribindex), C.GLint(size), C.GLenum(typ), cbool(normalized), C.GLuint(relativeoffset))
+}
+
+// VertexAttribI4i calls glVertexAttribI4i (GL_ES_VERSION_3_0).
+func VertexAttribI4i(index uint32, x int32, y int32, z int32, w int32) {
+	C.callVertexAttribI4i(C.uintptr_t(proc(procVertexAttribI4i)), C.GLuint(index), C.GLint(x), C.GLint(y), C.GLint(z), C.GLint(w))
+}
+
+// VertexAttribI4iv calls glVertexAttribI4iv (GL_ES_VERSION_3_0).
+func VertexAttribI4iv(index uint32, v *int32) {
+	C.callVertexAttribI4iv(C.uintptr_t(proc(procVertexAttribI4iv)), C.GLuint(index), unsafe.Pointer(v))
+}
+
+// VertexAttribI4ui calls glVertexAttribI4ui (GL_ES_VERSION_3_0).
+func VertexAttribI4ui(index uint32, x uint32, y uint32, z uint32, w uint32) {
+	C.callVertexAttribI4ui(C.uintptr_t(proc(procVertexAttribI4ui)), C.GLuint(index), C.GLuint(x), C.GLuint(y), C.GLuint(z), C.GLuint(w))
+}
+
+// VertexAttribI4uiv calls glVertexAttribI4uiv (GL_ES_VERSION_3_0).
+func VertexAttribI4uiv(index uint32, v *uint32) {
+	C.callVertexAttribI4uiv(C.uintptr_t(proc(procVertexAttribI4uiv)), C.GLuint(index), unsafe.Pointer(v))
+}
+
+// VertexAttribIFormat calls glVertexAttribIFormat (GL_ES_VERSION_3_1).
+func VertexAttribIFormat(attribindex uint32, size int32, typ Enum, relativeoffset uint32) {
+	C.callVertexAttribIFormat(C.uintptr_t(proc(procVertexAttribIFormat)), C.GLuint(attribindex), C.GLint(size), C.GLenum(typ), C.GLuint(relativeoffset))
+}
+
+// VertexAttribIPointer calls glVertexAttribIPointer (GL_ES_VERSION_3_0).
+func VertexAttribIPointer(index uint32, size int32, typ Enum, stride int32, pointer unsafe.Pointer) {
+	C.callVertexAttribIPointer(C.uintptr_t(proc(procVertexAttribIPointer)), C.GLuint(index), C.GLint(size), C.GLenum(typ), C.GLsizei(stride), pointer)
+}
+
+// VertexAttribPointer calls glVertexAttribPointer (GL_ES_VERSION_2_0).
+func VertexAttribPointer(index uint32, size int32, typ Enum, normalized bool, stride int32, pointer unsafe.Pointer) {
+	C.callVertexAttribPointer(C.uintptr_t(proc(procVertexAttribPointer)), C.GLuint(index), C.GLint(size), C.GLenum(typ), cbool(normalized), C.GLsizei(stride), pointer)
+}
+
+// VertexBindingDivisor calls glVertexBindingDivisor (GL_ES_VERSION_3_1).
+func VertexBindingDivisor(bindingindex uint32, divisor uint32) {
+	C.callVertexBindingDivisor(C.uintptr_t(proc(procVertexBindingDivisor)), C.GLuint(bindingindex), C.GLuint(divisor))
+}
+
+// Viewport calls glViewport (GL_ES_VERSION_2_0).
+func Viewport(x int32, y int32, width int32, height int32) {
+	C.callViewport(C.uintptr_t(proc(procViewport)), C.GLint(x), C.GLint(y), C.GLsizei(width), C.GLsizei(height))
+}
+
+// WaitSync calls glWaitSync (GL_ES_VERSION_3_0).
+func WaitSync(sync uintptr, flags uint32, timeout uint64) {
+	C.callWaitSync(C.uintptr_t(proc(procWaitSync)), C.uintptr_t(sync), C.GLbitfield(flags), C.GLuint64(timeout))
+}
@@ -0,0 +1,1875 @@
+// Code generated by "go run gen.go"; DO NOT EDIT.
+// API: gles2 3.2, extensions: GL_KHR_debug,GL_EXT_sRGB_write_control.
+
+package gl
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// ActiveShaderProgram calls glActiveShaderProgram (GL_ES_VERSION_3_1).
+func ActiveShaderProgram(pipeline uint32, program uint32) {
+	syscall.Syscall(proc(procActiveShaderProgram), 2, uintptr(pipeline), uintptr(program), 0)
+}
+
+// ActiveTexture calls glActiveTexture (GL_ES_VERSION_2_0).
+func ActiveTexture(texture Enum) {
+	syscall.Syscall(proc(procActiveTexture), 1, uintptr(texture), 0, 0)
+}
+
+// AttachShader calls glAttachShader (GL_ES_VERSION_2_0).
+func AttachShader(program uint32, shader uint32) {
+	syscall.Syscall(proc(procAttachShader), 2, uintptr(program), uintptr(shader), 0)
+}
+
+// BeginQuery calls glBeginQuery (GL_ES_VERSION_3_0).
+func BeginQuery(target Enum, id uint32) {
+	syscall.Syscall(proc(procBeginQuery), 2, uintptr(target), uintptr(id), 0)
+}
+
+// BeginTransformFeedback calls glBeginTransformFeedback (GL_ES_VERSION_3_0).
+func BeginTransformFeedback(primitiveMode Enum) {
+	syscall.Syscall(proc(procBeginTransformFeedback), 1, uintptr(primitiveMode), 0, 0)
+}
+
+// BindAttribLocation calls glBindAttribLocation (GL_ES_VERSION_2_0).
+func BindAttribLocation(program uint32, index uint32, name string) {
+	syscall.Syscall(proc(procBindAttribLocation), 3, uintptr(program), uintptr(index), uintptr(unsafe.Pointer(cString(name))))
+}
+
+// BindBuffer calls glBindBuffer (GL_ES_VERSION_2_0).
+func BindBuffer(target Enum, buffer uint32) {
+	syscall.Syscall(proc(procBindBuffer), 2, uintptr(target), uintptr(buffer), 0)
+}
+
+// BindBufferBase calls glBindBufferBase (GL_ES_VERSION_3_0).
+func BindBufferBase(target Enum, index uint32, buffer uint32) {
+	syscall.Syscall(proc(procBindBufferBase), 3, uintptr(target), uintptr(index), uintptr(buffer))
+}
+
+// BindBufferRange calls glBindBufferRange (GL_ES_VERSION_3_0).
+func BindBufferRange(target Enum, index uint32, buffer uint32, offset int, size int) {
+	syscall.Syscall6(proc(procBindBufferRange), 5, uintptr(target), uintptr(index), uintptr(buffer), uintptr(offset), uintptr(size), 0)
+}
+
+// BindFramebuffer calls glBindFramebuffer (GL_ES_VERSION_2_0).
+func BindFramebuffer(target Enum, framebuffer uint32) {
+	syscall.Syscall(proc(procBindFramebuffer), 2, uintptr(target), uintptr(framebuffer), 0)
+}
+
+// BindImageTexture calls glBindImageTexture (GL_ES_VERSION_3_1).
+func BindImageTexture(unit uint32, texture uint32, level int32, layered bool, layer int32, access Enum, format Enum) {
+	syscall.Syscall9(proc(procBindImageTexture), 7, uintptr(unit), uintptr(texture), uintptr(level), boolArg(layered), uintptr(layer), uintptr(access), uintptr(format), 0, 0)
+}
+
+// BindProgramPipeline calls glBindProgramPipeline (GL_ES_VERSION_3_1).
+func BindProgramPipeline(pipeline uint32) {
+	syscall.Syscall(proc(procBindProgramPipeline), 1, uintptr(pipeline), 0, 0)
+}
+
+// BindRenderbuffer calls glBindRenderbuffer (GL_ES_VERSION_2_0).
+func BindRenderbuffer(target Enum, renderbuffer uint32) {
+	syscall.Syscall(proc(procBindRenderbuffer), 2, uintptr(target), uintptr(renderbuffer), 0)
+}
+
+// BindSampler calls glBindSampler (GL_ES_VERSION_3_0).
+func BindSampler(unit uint32, sampler uint32) {
+	syscall.Syscall(proc(procBindSampler), 2, uintptr(unit), uintptr(sampler), 0)
+}
+
+// BindTexture calls glBindTexture (GL_ES_VERSION_2_0).
+func BindTexture(target Enum, texture uint32) {
+	syscall.Syscall(proc(procBindTexture), 2, uintptr(target), uintptr(texture), 0)
+}
+
+// BindTransformFeedback calls glBindTransformFeedback (GL_ES_VERSION_3_0).
+func BindTransformFeedback(target Enum, id uint32) {
+	syscall.Syscall(proc(procBindTransformFeedback), 2, uintptr(target), uintptr(id), 0)
+}
+
+// BindVertexArray calls glBindVertexArray (GL_ES_VERSION_3_0).
+func BindVertexArray(array uint32) {
+	syscall.Syscall(proc(procBindVertexArray), 1, uintptr(array), 0, 0)
+}
+
+// BindVertexBuffer calls glBindVertexBuffer (GL_ES_VERSION_3_1).
+func BindVertexBuffer(bindingindex uint32, buffer uint32, offset int, stride int32) {
+	syscall.Syscall6(proc(procBindVertexBuffer), 4, uintptr(bindingindex), uintptr(buffer), uintptr(offset), uintptr(stride), 0, 0)
+}
+
+// BlendBarrier calls glBlendBarrier (GL_ES_VERSION_3_2).
+func BlendBarrier() {
+	syscall.Syscall(proc(procBlendBarrier), 0, 0, 0, 0)
+}
+
+// BlendColor calls glBlendColor (GL_ES_VERSION_2_0).
+//
+// It is not supported on windows/arm64: floating-point arguments are not passed by the syscall package.
+func BlendColor(red float32, green float32, blue float32, alpha float32) {
+	panic("gl: glBlendColor is not supported on windows/arm64")
+}
+
+// BlendEquation calls glBlendEquation (GL_ES_VERSION_2_0).
+func BlendEquation(mode Enum) {
+	syscall.Syscall(proc(procBlendEquation), 1, uintptr(mode), 0, 0)
+}
+
+// BlendEquationSeparate calls glBlendEquationSeparate (GL_ES_VERSION_2_0).
+func BlendEquationSeparate(modeRGB Enum, modeAlpha Enum) {
+	syscall.Syscall(proc(procBlendEquationSeparate), 2, uintptr(modeRGB), uintptr(modeAlpha), 0)
+}
+
+// BlendEquationSeparatei calls glBlendEquationSeparatei (GL_ES_VERSION_3_2).
+func BlendEquationSeparatei(buf uint32, modeRGB Enum, modeAlpha Enum) {
+	syscall.Syscall(proc(procBlendEquationSeparatei), 3, uintptr(buf), uintptr(modeRGB), uintptr(modeAlpha))
+}
+
+// BlendEquationi calls glBlendEquationi (GL_ES_VERSION_3_2).
+func BlendEquationi(buf uint32, mode Enum) {
+	syscall.Syscall(proc(procBlendEquationi), 2, uintptr(buf), uintptr(mode), 0)
+}
+
+// BlendFunc calls glBlendFunc (GL_ES_VERSION_2_0).
+func BlendFunc(sfactor Enum, dfactor Enum) {
+	syscall.Syscall(proc(procBlendFunc), 2, uintptr(sfactor), uintptr(dfactor), 0)
+}
+
+// BlendFuncSeparate calls glBlendFuncSeparate (GL_ES_VERSION_2_0).
+func BlendFuncSeparate(sfactorRGB Enum, dfactorRGB Enum, sfactorAlpha Enum, dfactorAlpha Enum) {
+	syscall.Syscall6(proc(procBlendFuncSeparate), 4, uintptr(sfactorRGB), uintptr(dfactorRGB), uintptr(sfactorAlpha), uintptr(dfactorAlpha), 0, 0)
+}
+
+// BlendFuncSeparatei calls glBlendFuncSeparatei (GL_ES_VERSION_3_2).
+func BlendFuncSeparatei(buf uint32, srcRGB Enum, dstRGB Enum, srcAlpha Enum, dstAlpha Enum) {
+	syscall.Syscall6(proc(procBlendFuncSeparatei), 5, uintptr(buf), uintptr(srcRGB), uintptr(dstRGB), uintptr(srcAlpha), uintptr(dstAlpha), 0)
+}
+
+// BlendFunci calls glBlendFunci (GL_ES_VERSION_3_2).
+func BlendFunci(buf uint32, src Enum, dst Enum) {
+	syscall.Syscall(proc(procBlendFunci), 3, uintptr(buf), uintptr(src), uintptr(dst))
+}
+
+// BlitFramebuffer calls glBlitFramebuffer (GL_ES_VERSION_3_0).
+func BlitFramebuffer(srcX0 int32, srcY0 int32, srcX1 int32, srcY1 int32, dstX0 int32, dstY0 int32, dstX1 int32, dstY1 int32, mask uint32, filter Enum) {
+	syscall.Syscall12(proc(procBlitFramebuffer), 10, uintptr(srcX0), uintptr(srcY0), uintptr(srcX1), uintptr(srcY1), uintptr(dstX0), uintptr(dstY0), uintptr(dstX1), uintptr(dstY1), uintptr(mask), uintptr(filter), 0, 0)
+}
+
+// BufferData calls glBufferData (GL_ES_VERSION_2_0).
+func BufferData(target Enum, size int, data unsafe.Pointer, usage Enum) {
+	syscall.Syscall6(proc(procBufferData), 4, uintptr(target), uintptr(size), uintptr(data), uintptr(usage), 0, 0)
+}
+
+// BufferSubData calls glBufferSubData (GL_ES_VERSION_2_0).
+func BufferSubData(target Enum, offset int, size int, data unsafe.Pointer) {
+	syscall.Syscall6(proc(procBufferSubData), 4, uintptr(target), uintptr(offset), uintptr(size), uintptr(data), 0, 0)
+}
+
+// CheckFramebufferStatus calls glCheckFramebufferStatus (GL_ES_VERSION_2_0).
+func CheckFramebufferStatus(target Enum) Enum {
+	r1, _, _ := syscall.Syscall(proc(procCheckFramebufferStatus), 1, uintptr(target), 0, 0)
+	return Enum(r1)
+}
+
+// Clear calls glClear (GL_ES_VERSION_2_0).
+func Clear(mask uint32) {
+	syscall.Syscall(proc(procClear), 1, uintptr(mask), 0, 0)
+}
+
+// ClearBufferfi calls glClearBufferfi (GL_ES_VERSION_3_0).
+//
+// It is not supported on windows/arm64: floating-point arguments are not passed by the syscall package.
+func ClearBufferfi(buffer Enum, drawbuffer int32, depth float32, stencil int32) {
+	panic("gl: glClearBufferfi is not supported on windows/arm64")
+}
+
+// ClearBufferfv calls glClearBufferfv (GL_ES_VERSION_3_0).
+func ClearBufferfv(buffer Enum, drawbuffer int32, value *float32) {
+	syscall.Syscall(proc(procClearBufferfv), 3, uintptr(buffer), uintptr(drawbuffer), uintptr(unsafe.Pointer(value)))
+}
+
+// ClearBufferiv calls glClearBufferiv (GL_ES_VERSION_3_0).
+func ClearBufferiv(buffer Enum, drawbuffer int32, value *int32) {
+	syscall.Syscall(proc(procClearBufferiv), 3, uintptr(buffer), uintptr(drawbuffer), uintptr(unsafe.Pointer(value)))
+}
+
+// ClearBufferuiv calls glClearBufferuiv (GL_ES_VERSION_3_0).
+func ClearBufferuiv(buffer Enum, drawbuffer int32, value *uint32) {
+	syscall.Syscall(proc(procClearBufferuiv), 3, uintptr(buffer), uintptr(drawbuffer), uintptr(unsafe.Pointer(value)))
+}
+
+// ClearColor calls glClearColor (GL_ES_VERSION_2_0).
+//
+// It is not supported on windows/arm64: floating-point arguments are not passed by the syscall package.
+func ClearColor(red float32, green float32, blue float32, alpha float32) {
+	panic("gl: glClearColor is not supported on windows/arm64")
+}
+
+// ClearDepthf calls glClearDepthf (GL_ES_VERSION_2_0).
+//
+// It is not supported on windows/arm64: floating-point arguments are not passed by the syscall package.
+func ClearDepthf(d float32) {
+	panic("gl: glClearDepthf is not supported on windows/arm64")
+}
+
+// ClearStencil calls glClearStencil (GL_ES_VERSION_2_0).
+func ClearStencil(s int32) {
+	syscall.Syscall(proc(procClearStencil), 1, uintptr(s), 0, 0)
+}
+
+// ClientWaitSync calls glClientWaitSync (GL_ES_VERSION_3_0).
+func ClientWaitSync(sync uintptr, flags uint32, timeout uint64) Enum {
+	r1, _, _ := syscall.Syscall(proc(procClientWaitSync), 3, uintptr(sync), uintptr(flags), uintptr(timeout))
+	return Enum(r1)
+}
+
+// ColorMask calls glColorMask (GL_ES_VERSION_2_0).
+func ColorMask(red bool, green bool, blue bool, alpha bool) {
+	syscall.Syscall6(proc(procColorMask), 4, boolArg(red), boolArg(green), boolArg(blue), boolArg(alpha), 0, 0)
+}
+
+// ColorMaski calls glColorMaski (GL_ES_VERSION_3_2).
+func ColorMaski(index uint32, r bool, g bool, b bool, a bool) {
+	syscall.Syscall6(proc(procColorMaski), 5, uintptr(index), boolArg(r), boolArg(g), boolArg(b), boolArg(a), 0)
+}
+
+// CompileShader calls glCompileShader (GL_ES_VERSION_2_0).
+func CompileShader(shader uint32) {
+	syscall.Syscall(proc(procCompileShader), 1, uintptr(shader), 0, 0)
+}
+
+// CompressedTexImage2D calls glCompressedTexImage2D (GL_ES_VERSION_2_0).
+func CompressedTexImage2D(target Enum, level int32, internalformat Enum, width int32, height int32, border int32, imageSize int32, data unsafe.Pointer) {
+	syscall.Syscall9(proc(procCompressedTexImage2D), 8, uintptr(target), uintptr(level), uintptr(internalformat), uintptr(width), uintptr(height), uintptr(border), uintptr(imageSize), uintptr(data), 0)
+}
+
+// CompressedTexImage3D calls glCompressedTexImage3D (GL_ES_VERSION_3_0).
+func CompressedTexImage3D(target Enum, level int32, internalformat Enum, width int32, height int32, depth int32, border int32, imageSize int32, data unsafe.Pointer) {
+	syscall.Syscall9(proc(procCompressedTexImage3D), 9, uintptr(target), uintptr(level), uintptr(internalformat), uintptr(width), uintptr(height), uintptr(depth), uintptr(border), uintptr(imageSize), uintptr(data))
+}
+
+// CompressedTexSubImage2D calls glCompressedTexSubImage2D (GL_ES_VERSION_2_0).
+func CompressedTexSubImage2D(target Enum, level int32, xoffset int32, yoffset int32, width int32, height int32, format Enum, imageSize int32, data unsafe.Pointer) {
+	syscall.Syscall9(proc(procCompressedTexSubImage2D), 9, uintptr(target), uintptr(level), uintptr(xoffset), uintptr(yoffset), uintptr(width), uintptr(height), uintptr(format), uintptr(imageSize), uintptr(data))
+}
+
+// CompressedTexSubImage3D calls glCompressedTexSubImage3D (GL_ES_VERSION_3_0).
+func CompressedTexSubImage3D(target Enum, level int32, xoffset int32, yoffset int32, zoffset int32, width int32, height int32, depth int32, format Enum, imageSize int32, data unsafe.Pointer) {
+	syscall.Syscall12(proc(procCompressedTexSubImage3D), 11, uintptr(target), uintptr(level), uintptr(xoffset), uintptr(yoffset), uintptr(zoffset), uintptr(width), uintptr(height), uintptr(depth), uintptr(format), uintptr(imageSize), uintptr(data), 0)
+}
+
+// CopyBufferSubData calls glCopyBufferSubData (GL_ES_VERSION_3_0).
+func CopyBufferSubData(readTarget Enum, writeTarget Enum, readOffset int, writeOffset int, size int) {
+	syscall.Syscall6(proc(procCopyBufferSubData), 5, uintptr(readTarget), uintptr(writeTarget), uintptr(readOffset), uintptr(writeOffset), uintptr(size), 0)
+}
+
+// CopyImageSubData calls glCopyImageSubData (GL_ES_VERSION_3_2).
+func CopyImageSubData(srcName uint32, srcTarget Enum, srcLevel int32, srcX int32, srcY int32, srcZ int32, dstName uint32, dstTarget Enum, dstLevel int32, dstX int32, dstY int32, dstZ int32, srcWidth int32, srcHeight int32, srcDepth int32) {
+	syscall.Syscall15(proc(procCopyImageSubData), 15, uintptr(srcName), uintptr(srcTarget), uintptr(srcLevel), uintptr(srcX), uintptr(srcY), uintptr(srcZ), uintptr(dstName), uintptr(dstTarget), uintptr(dstLevel), uintptr(dstX), uintptr(dstY), uintptr(dstZ), uintptr(srcWidth), uintptr(srcHeight), uintptr(srcDepth))
+}
+
+// CopyTexImage2D calls glCopyTexImage2D (GL_ES_VERSION_2_0).
+func CopyTexImage2D(target Enum, level int32, internalformat Enum, x int32, y int32, width int32, height int32, border int32) {
+	syscall.Syscall9(proc(procCopyTexImage2D), 8, uintptr(target), uintptr(level), uintptr(internalformat), uintptr(x), uintptr(y), uintptr(width), uintptr(height), uintptr(border), 0)
+}
+
+// CopyTexSubImage2D calls glCopyTexSubImage2D (GL_ES_VERSION_2_0).
+func CopyTexSubImage2D(target Enum, level int32, xoffset int32, yoffset int32, x int32, y int32, width int32, height int32) {
+	syscall.Syscall9(proc(procCopyTexSubImage2D), 8, uintptr(target), uintptr(level), uintptr(xoffset), uintptr(yoffset), uintptr(x), uintptr(y), uintptr(width), uintptr(height), 0)
+}
+
+// CopyTexSubImage3D calls glCopyTexSubImage3D (GL_ES_VERSION_3_0).
+func CopyTexSubImage3D(target Enum, level int32, xoffset int32, yoffset int32, zoffset int32, x int32, y int32, width int32, height int32) {
+	syscall.Syscall9(proc(procCopyTexSubImage3D), 9, uintptr(target), uintptr(level), uintptr(xoffset), uintptr(yoffset), uintptr(zoffset), uintptr(x), uintptr(y), uintptr(width), uintptr(height))
+}
+
+// CreateProgram calls glCreateProgram (GL_ES_VERSION_2_0).
+func CreateProgram() uint32 {
+	r1, _, _ := syscall.Syscall(proc(procCreateProgram), 0, 0, 0, 0)
+	return uint32(r1)
+}
+
+// CreateShader calls glCreateShader (GL_ES_VERSION_2_0).
+func CreateShader(typ Enum) uint32 {
+	r1, _, _ := syscall.Syscall(proc(procCreateShader), 1, uintptr(typ), 0, 0)
+	return uint32(r1)
+}
+
+// CreateShaderProgramv calls glCreateShaderProgramv (GL_ES_VERSION_3_1).
+func CreateShaderProgramv(typ Enum, count int32, strings **uint8) uint32 {
+	r1, _, _ := syscall.Syscall(proc(procCreateShaderProgramv), 3, uintptr(typ), uintptr(count), uintptr(unsafe.Pointer(strings)))
+	return uint32(r1)
+}
+
+// CullFace calls glCullFace (GL_ES_VERSION_2_0).
+func CullFace(mode Enum) {
+	syscall.Syscall(proc(procCullFace), 1, uintptr(mode), 0, 0)
+}
+
+// DebugMessageControl calls glDebugMessageControl or glDebugMessageControlKHR (GL_ES_VERSION_3_2).
+func DebugMessageControl(source Enum, typ Enum, severity Enum, count int32, ids *uint32, enabled bool) {
+	syscall.Syscall6(proc(procDebugMessageControl), 6, uintptr(source), uintptr(typ), uintptr(severity), uintptr(count), uintptr(unsafe.Pointer(ids)), boolArg(enabled))
+}
+
+// DebugMessageInsert calls glDebugMessageInsert or glDebugMessageInsertKHR (GL_ES_VERSION_3_2).
+func DebugMessageInsert(source Enum, typ Enum, id uint32, severity Enum, length int32, buf string) {
+	syscall.Syscall6(proc(procDebugMessageInsert), 6, uintptr(source), uintptr(typ), uintptr(id), uintptr(severity), uintptr(length), uintptr(unsafe.Pointer(cString(buf))))
+}
+
+// DeleteBuffers calls glDeleteBuffers (GL_ES_VERSION_2_0).
+func DeleteBuffers(n int32, buffers *uint32) {
+	syscall.Syscall(proc(procDeleteBuffers), 2, uintptr(n), uintptr(unsafe.Pointer(buffers)), 0)
+}
+
+// DeleteFramebuffers calls glDeleteFramebuffers (GL_ES_VERSION_2_0).
+func DeleteFramebuffers(n int32, framebuffers *uint32) {
+	syscall.Syscall(proc(procDeleteFramebuffers), 2, uintptr(n), uintptr(unsafe.Pointer(framebuffers)), 0)
+}
+
+// DeleteProgram calls glDeleteProgram (GL_ES_VERSION_2_0).
+func DeleteProgram(program uint32) {
+	syscall.Syscall(proc(procDeleteProgram), 1, uintptr(program), 0, 0)
+}
+
+// DeleteProgramPipelines calls glDeleteProgramPipelines (GL_ES_VERSION_3_1).
+func DeleteProgramPipelines(n int32, pipelines *uint32) {
+	syscall.Syscall(proc(procDeleteProgramPipelines), 2, uintptr(n), uintptr(unsafe.Pointer(pipelines)), 0)
+}
+
+// DeleteQueries calls glDeleteQueries (GL_ES_VERSION_3_0).
+func DeleteQueries(n int32, ids *uint32) {
+	syscall.Syscall(proc(procDeleteQueries), 2, uintptr(n), uintptr(unsafe.Pointer(ids)), 0)
+}
+
+// DeleteRenderbuffers calls glDeleteRenderbuffers (GL_ES_VERSION_2_0).
+func DeleteRenderbuffers(n int32, renderbuffers *uint32) {
+	syscall.Syscall(proc(procDeleteRenderbuffers), 2, uintptr(n), uintptr(unsafe.Pointer(renderbuffers)), 0)
+}
+
+// DeleteSamplers calls glDeleteSamplers (GL_ES_VERSION_3_0).
+func DeleteSamplers(count int32, samplers *uint32) {
+	syscall.Syscall(proc(procDeleteSamplers), 2, uintptr(count), uintptr(unsafe.Pointer(samplers)), 0)
+}
+
+// DeleteShader calls glDeleteShader (GL_ES_VERSION_2_0).
+func DeleteShader(shader uint32) {
+	syscall.Syscall(proc(procDeleteShader), 1, uintptr(shader), 0, 0)
+}
+
+// DeleteSync calls glDeleteSync (GL_ES_VERSION_3_0).
+func DeleteSync(sync uintptr) {
+	syscall.Syscall(proc(procDeleteSync), 1, uintptr(sync), 0, 0)
+}
+
+// DeleteTextures calls glDeleteTextures (GL_ES_VERSION_2_0).
+func DeleteTextures(n int32, textures *uint32) {
+	syscall.Syscall(proc(procDeleteTextures), 2, uintptr(n), uintptr(unsafe.Pointer(textures)), 0)
+}
+
+// DeleteTransformFeedbacks calls glDeleteTransformFeedbacks (GL_ES_VERSION_3_0).
+func DeleteTransformFeedbacks(n int32, ids *uint32) {
+	syscall.Syscall(proc(procDeleteTransformFeedbacks), 2, uintptr(n), uintptr(unsafe.Pointer(ids)), 0)
+}
+
+// DeleteVertexArrays calls glDeleteVertexArrays (GL_ES_VERSION_3_0).
+func DeleteVertexArrays(n int32, arrays *uint32) {
+	syscall.Syscall(proc(procDeleteVertexArrays), 2, uintptr(n), uintptr(unsafe.Pointer(arrays)), 0)
+}
+
+// DepthFunc calls glDepthFunc (GL_ES_VERSION_2_0).
+func DepthFunc(fn Enum) {
+	syscall.Syscall(proc(procDepthFunc), 1, uintptr(fn), 0, 0)
+}
+
+// DepthMask calls glDepthMask (GL_ES_VERSION_2_0).
+func DepthMask(flag bool) {
+	syscall.Syscall(proc(procDepthMask), 1, boolArg(flag), 0, 0)
+}
+
+// DepthRangef calls glDepthRangef (GL_ES_VERSION_2_0).
+//
+// It is not supported on windows/arm64: floating-point arguments are not passed by the syscall package.
+func DepthRangef(n float32, f float32) {
+	panic("gl: glDepthRangef is not supported on windows/arm64")
+}
+
+// DetachShader calls glDetachShader (GL_ES_VERSION_2_0).
+func DetachShader(program uint32, shader uint32) {
+	syscall.Syscall(proc(procDetachShader), 2, uintptr(program), uintptr(shader), 0)
+}
+
+// Disable calls glDisable (GL_ES_VERSION_2_0).
+func Disable(cap Enum) {
+	syscall.Syscall(proc(procDisable), 1, uintptr(cap), 0, 0)
+}
+
+// DisableVertexAttribArray calls glDisableVertexAttribArray (GL_ES_VERSION_2_0).
+func DisableVertexAttribArray(index uint32) {
+	syscall.Syscall(proc(procDisableVertexAttribArray), 1, uintptr(index), 0, 0)
+}
+
+// Disablei calls glDisablei (GL_ES_VERSION_3_2).
+func Disablei(target Enum, index uint32) {
+	syscall.Syscall(proc(procDisablei), 2, uintptr(target), uintptr(index), 0)
+}
+
+// DispatchCompute calls glDispatchCompute (GL_ES_VERSION_3_1).
+func DispatchCompute(num_groups_x uint32, num_groups_y uint32, num_groups_z uint32) {
+	syscall.Syscall(proc(procDispatchCompute), 3, uintptr(num_groups_x), uintptr(num_groups_y), uintptr(num_groups_z))
+}
+
+// DispatchComputeIndirect calls glDispatchComputeIndirect (GL_ES_VERSION_3_1).
+func DispatchComputeIndirect(indirect int) {
+	syscall.Syscall(proc(procDispatchComputeIndirect), 1, uintptr(indirect), 0, 0)
+}
+
+// DrawArrays calls glDrawArrays (GL_ES_VERSION_2_0).
+func DrawArrays(mode Enum, first int32, count int32) {
+	syscall.Syscall(proc(procDrawArrays), 3, uintptr(mode), uintptr(first), uintptr(count))
+}
+
+// DrawArraysIndirect calls glDrawArraysIndirect (GL_ES_VERSION_3_1).
+func DrawArraysIndirect(mode Enum, indirect unsafe.Pointer) {
+	syscall.Syscall(proc(procDrawArraysIndirect), 2, uintptr(mode), uintptr(indirect), 0)
+}
+
+// DrawArraysInstanced calls glDrawArraysInstanced (GL_ES_VERSION_3_0).
+func DrawArraysInstanced(mode Enum, first int32, count int32, instancecount int32) {
+	syscall.Syscall6(proc(procDrawArraysInstanced), 4, uintptr(mode), uintptr(first), uintptr(count), uintptr(instancecount), 0, 0)
+}
+
+// DrawBuffers calls glDrawBuffers (GL_ES_VERSION_3_0).
+func DrawBuffers(n int32, bufs *Enum) {
+	syscall.Syscall(proc(procDrawBuffers), 2, uintptr(n), uintptr(unsafe.Pointer(bufs)), 0)
+}
+
+// DrawElements calls glDrawElements (GL_ES_VERSION_2_0).
+func DrawElements(mode Enum, count int32, typ Enum, indices unsafe.Pointer) {
+	syscall.Syscall6(proc(procDrawElements), 4, uintptr(mode), uintptr(count), uintptr(typ), uintptr(indices), 0, 0)
+}
+
+// DrawElementsBaseVertex calls glDrawElementsBaseVertex (GL_ES_VERSION_3_2).
+func DrawElementsBaseVertex(mode Enum, count int32, typ Enum, indices unsafe.Pointer, basevertex int32) {
+	syscall.Syscall6(proc(procDrawElementsBaseVertex), 5, uintptr(mode), uintptr(count), uintptr(typ), uintptr(indices), uintptr(basevertex), 0)
+}
+
+// DrawElementsIndirect calls glDrawElementsIndirect (GL_ES_VERSION_3_1).
+func DrawElementsIndirect(mode Enum, typ Enum, indirect unsafe.Pointer) {
+	syscall.Syscall(proc(procDrawElementsIndirect), 3, uintptr(mode), uintptr(typ), uintptr(indirect))
+}
+
+// DrawElementsInstanced calls glDrawElementsInstanced (GL_ES_VERSION_3_0).
+func DrawElementsInstanced(mode Enum, count int32, typ Enum, indices unsafe.Pointer, instancecount int32) {
+	syscall.Syscall6(proc(procDrawElementsInstanced), 5, uintptr(mode), uintptr(count), uintptr(typ), uintptr(indices), uintptr(instancecount), 0)
+}
+
+// DrawElementsInstancedBaseVertex calls glDrawElementsInstancedBaseVertex (GL_ES_VERSION_3_2).
+func DrawElementsInstancedBaseVertex(mode Enum, count int32, typ Enum, indices unsafe.Pointer, instancecount int32, basevertex int32) {
+	syscall.Syscall6(proc(procDrawElementsInstancedBaseVertex), 6, uintptr(mode), uintptr(count), uintptr(typ), uintptr(indices), uintptr(instancecount), uintptr(basevertex))
+}
+
+// DrawRangeElements calls glDrawRangeElements (GL_ES_VERSION_3_0).
+func DrawRangeElements(mode Enum, start uint32, end uint32, count int32, typ Enum, indices unsafe.Pointer) {
+	syscall.Syscall6(proc(procDrawRangeElements), 6, uintptr(mode), uintptr(start), uintptr(end), uintptr(count), uintptr(typ), uintptr(indices))
+}
+
+// DrawRangeElementsBaseVertex calls glDrawRangeElementsBaseVertex (GL_ES_VERSION_3_2).
+func DrawRangeElementsBaseVertex(mode Enum, start uint32, end uint32, count int32, typ Enum, indices unsafe.Pointer, basevertex int32) {
+	syscall.Syscall9(proc(procDrawRangeElementsBaseVertex), 7, uintptr(mode), uintptr(start), uintptr(end), uintptr(count), uintptr(typ), uintptr(indices), uintptr(basevertex), 0, 0)
+}
+
+// Enable calls glEnable (GL_ES_VERSION_2_0).
+func Enable(cap Enum) {
+	syscall.Syscall(proc(procEnable), 1, uintptr(cap), 0, 0)
+}
+
+// EnableVertexAttribArray calls glEnableVertexAttribArray (GL_ES_VERSION_2_0).
+func EnableVertexAttribArray(index uint32) {
+	syscall.Syscall(proc(procEnableVertexAttribArray), 1, uintptr(index), 0, 0)
+}
+
+// Enablei calls glEnablei (GL_ES_VERSION_3_2).
+func Enablei(target Enum, index uint32) {
+	syscall.Syscall(proc(procEnablei), 2, uintptr(target), uintptr(index), 0)
+}
+
+// EndQuery calls glEndQuery (GL_ES_VERSION_3_0).
+func EndQuery(target Enum) {
+	syscall.Syscall(proc(procEndQuery), 1, uintptr(target), 0, 0)
+}
+
+// EndTransformFeedback calls glEndTransformFeedback (GL_ES_VERSION_3_0).
+func EndTransformFeedback() {
+	syscall.Syscall(proc(procEndTransformFeedback), 0, 0, 0, 0)
+}
+
+// FenceSync calls glFenceSync (GL_ES_VERSION_3_0).
+func FenceSync(condition Enum, flags uint32) uintptr {
+	r1, _, _ := syscall.Syscall(proc(procFenceSync), 2, uintptr(condition), uintptr(flags), 0)
+	return uintptr(r1)
+}
+
+// Finish calls glFinish (GL_ES_VERSION_2_0).
+func Finish() {
+	syscall.Syscall(proc(procFinish), 0, 0, 0, 0)
+}
+
+// Flush calls glFlush (GL_ES_VERSION_2_0).
+func Flush() {
+	syscall.Syscall(proc(procFlush), 0, 0, 0, 0)
+}
+
+// FlushMappedBufferRange calls glFlushMappedBufferRange (GL_ES_VERSION_3_0).
+func FlushMappedBufferRange(target Enum, offset int, length int) {
+	syscall.Syscall(proc(procFlushMappedBufferRange), 3, uintptr(target), uintptr(offset), uintptr(length))
+}
+
+// FramebufferParameteri calls glFramebufferParameteri (GL_ES_VERSION_3_1).
+func FramebufferParameteri(target Enum, pname Enum, param int32) {
+	syscall.Syscall(proc(procFramebufferParameteri), 3, uintptr(target), uintptr(pname), uintptr(param))
+}
+
+// FramebufferRenderbuffer calls glFramebufferRenderbuffer (GL_ES_VERSION_2_0).
+func FramebufferRenderbuffer(target Enum, attachment Enum, renderbuffertarget Enum, renderbuffer uint32) {
+	syscall.Syscall6(proc(procFramebufferRenderbuffer), 4, uintptr(target), uintptr(attachment), uintptr(renderbuffertarget), uintptr(renderbuffer), 0, 0)
+}
+
+// FramebufferTexture calls glFramebufferTexture (GL_ES_VERSION_3_2).
+func FramebufferTexture(target Enum, attachment Enum, texture uint32, level int32) {
+	syscall.Syscall6(proc(procFramebufferTexture), 4, uintptr(target), uintptr(attachment), uintptr(texture), uintptr(level), 0, 0)
+}
+
+// FramebufferTexture2D calls glFramebufferTexture2D (GL_ES_VERSION_2_0).
+func FramebufferTexture2D(target Enum, attachment Enum, textarget Enum, texture uint32, level int32) {
+	syscall.Syscall6(proc(procFramebufferTexture2D), 5, uintptr(target), uintptr(attachment), uintptr(textarget), uintptr(texture), uintptr(level), 0)
+}
+
+// FramebufferTextureLayer calls glFramebufferTextureLayer (GL_ES_VERSION_3_0).
+func FramebufferTextureLayer(target Enum, attachment Enum, texture uint32, level int32, layer int32) {
+	syscall.Syscall6(proc(procFramebufferTextureLayer), 5, uintptr(target), uintptr(attachment), uintptr(texture), uintptr(level), uintptr(layer), 0)
+}
+
+// FrontFace calls glFrontFace (GL_ES_VERSION_2_0).
+func FrontFace(mode Enum) {
+	syscall.Syscall(proc(procFrontFace), 1, uintptr(mode), 0, 0)
+}
+
+// GenBuffers calls glGenBuffers (GL_ES_VERSION_2_0).
+func GenBuffers(n int32, buffers *uint32) {
+	syscall.Syscall(proc(procGenBuffers), 2, uintptr(n), uintptr(unsafe.Pointer(buffers)), 0)
+}
+
+// GenFramebuffers calls glGenFramebuffers (GL_ES_VERSION_2_0).
+func GenFramebuffers(n int32, framebuffers *uint32) {
+	syscall.Syscall(proc(procGenFramebuffers), 2, uintptr(n), uintptr(unsafe.Pointer(framebuffers)), 0)
+}
+
+// GenProgramPipelines calls glGenProgramPipelines (GL_ES_VERSION_3_1).
+func GenProgramPipelines(n int32, pipelines *uint32) {
+	syscall.Syscall(proc(procGenProgramPipelines), 2, uintptr(n), uintptr(unsafe.Pointer(pipelines)), 0)
+}
+
+// GenQueries calls glGenQueries (GL_ES_VERSION_3_0).
+func GenQueries(n int32, ids *uint32) {
+	syscall.Syscall(proc(procGenQueries), 2, uintptr(n), uintptr(unsafe.Pointer(ids)), 0)
+}
+
+// GenRenderbuffers calls glGenRenderbuffers (GL_ES_VERSION_2_0).
+func GenRenderbuffers(n int32, renderbuffers *uint32) {
+	syscall.Syscall(proc(procGenRenderbuffers), 2, uintptr(n), uintptr(unsafe.Pointer(renderbuffers)), 0)
+}
+
+// GenSamplers calls glGenSamplers (GL_ES_VERSION_3_0).
+func GenSamplers(count int32, samplers *uint32) {
+	syscall.Syscall(proc(procGenSamplers), 2, uintptr(count), uintptr(unsafe.Pointer(samplers)), 0)
+}
+
+// GenTextures calls glGenTextures (GL_ES_VERSION_2_0).
+func GenTextures(n int32, textures *uint32) {
+	syscall.Syscall(proc(procGenTextures), 2, uintptr(n), uintptr(unsafe.Pointer(textures)), 0)
+}
+
+// GenTransformFeedbacks calls glGenTransformFeedbacks (GL_ES_VERSION_3_0).
+func GenTransformFeedbacks(n int32, ids *uint32) {
+	syscall.Syscall(proc(procGenTransformFeedbacks), 2, uintptr(n), uintptr(unsafe.Pointer(ids)), 0)
+}
+
+// GenVertexArrays calls glGenVertexArrays (GL_ES_VERSION_3_0).
+func GenVertexArrays(n int32, arrays *uint32) {
+	syscall.Syscall(proc(procGenVertexArrays), 2, uintptr(n), uintptr(unsafe.Pointer(arrays)), 0)
+}
+
+// GenerateMipmap calls glGenerateMipmap (GL_ES_VERSION_2_0).
+func GenerateMipmap(target Enum) {
+	syscall.Syscall(proc(procGenerateMipmap), 1, uintptr(target), 0, 0)
+}
+
+// GetActiveAttrib calls glGetActiveAttrib (GL_ES_VERSION_2_0).
+func GetActiveAttrib(program uint32, index uint32, bufSize int32, length *int32, size *int32, typ *Enum, name *uint8) {
+	syscall.Syscall9(proc(procGetActiveAttrib), 7, uintptr(program), uintptr(index), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(size)), uintptr(unsafe.Pointer(typ)), uintptr(unsafe.Pointer(name)), 0, 0)
+}
+
+// GetActiveUniform calls glGetActiveUniform (GL_ES_VERSION_2_0).
+func GetActiveUniform(program uint32, index uint32, bufSize int32, length *int32, size *int32, typ *Enum, name *uint8) {
+	syscall.Syscall9(proc(procGetActiveUniform), 7, uintptr(program), uintptr(index), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(size)), uintptr(unsafe.Pointer(typ)), uintptr(unsafe.Pointer(name)), 0, 0)
+}
+
+// GetActiveUniformBlockName calls glGetActiveUniformBlockName (GL_ES_VERSION_3_0).
+func GetActiveUniformBlockName(program uint32, uniformBlockIndex uint32, bufSize int32, length *int32, uniformBlockName *uint8) {
+	syscall.Syscall6(proc(procGetActiveUniformBlockName), 5, uintptr(program), uintptr(uniformBlockIndex), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(uniformBlockName)), 0)
+}
+
+// GetActiveUniformBlockiv calls glGetActiveUniformBlockiv (GL_ES_VERSION_3_0).
+func GetActiveUniformBlockiv(program uint32, uniformBlockIndex uint32, pname Enum, params *int32) {
+	syscall.Syscall6(proc(procGetActiveUniformBlockiv), 4, uintptr(program), uintptr(uniformBlockIndex), uintptr(pname), uintptr(unsafe.Pointer(params)), 0, 0)
+}
+
+// GetActiveUniformsiv calls glGetActiveUniformsiv (GL_ES_VERSION_3_0).
+func GetActiveUniformsiv(program uint32, uniformCount int32, uniformIndices *uint32, pname Enum, params *int32) {
+	syscall.Syscall6(proc(procGetActiveUniformsiv), 5, uintptr(program), uintptr(uniformCount), uintptr(unsafe.Pointer(uniformIndices)), uintptr(pname), uintptr(unsafe.Pointer(params)), 0)
+}
+
+// GetAttachedShaders calls glGetAttachedShaders (GL_ES_VERSION_2_0).
+func GetAttachedShaders(program uint32, maxCount int32, count *int32, shaders *uint32) {
+	syscall.Syscall6(proc(procGetAttachedShaders), 4, uintptr(program), uintptr(maxCount), uintptr(unsafe.Pointer(count)), uintptr(unsafe.Pointer(shaders)), 0, 0)
+}
+
+// GetAttribLocation calls glGetAttribLocation (GL_ES_VERSION_2_0).
+func GetAttribLocation(program uint32, name string) int32 {
+	r1, _, _ := syscall.Syscall(proc(procGetAttribLocation), 2, uintptr(program), uintptr(unsafe.Pointer(cString(name))), 0)
+	return int32(r1)
+}
+
+// GetBooleani_v calls glGetBooleani_v (GL_ES_VERSION_3_1).
+func GetBooleani_v(target Enum, index uint32, data *bool) {
+	syscall.Syscall(proc(procGetBooleani_v), 3, uintptr(target), uintptr(index), uintptr(unsafe.Pointer(data)))
+}
+
+// GetBooleanv calls glGetBooleanv (GL_ES_VERSION_2_0).
+func GetBooleanv(pname Enum, data *bool) {
+	syscall.Syscall(proc(procGetBooleanv), 2, uintptr(pname), uintptr(unsafe.Pointer(data)), 0)
+}
+
+// GetBufferParameteri64v calls glGetBufferParameteri64v (GL_ES_VERSION_3_0).
+func GetBufferParameteri64v(target Enum, pname Enum, params *int64) {
+	syscall.Syscall(proc(procGetBufferParameteri64v), 3, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+}
+
+// GetBufferParameteriv calls glGetBufferParameteriv (GL_ES_VERSION_2_0).
+func GetBufferParameteriv(target Enum, pname Enum, params *int32) {
+	syscall.Syscall(proc(procGetBufferParameteriv), 3, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+}
+
+// GetBufferPointerv calls glGetBufferPointerv (GL_ES_VERSION_3_0).
+func GetBufferPointerv(target Enum, pname Enum, params *unsafe.Pointer) {
+	syscall.Syscall(proc(procGetBufferPointerv), 3, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+}
+
+// GetDebugMessageLog calls glGetDebugMessageLog or glGetDebugMessageLogKHR (GL_ES_VERSION_3_2).
+func GetDebugMessageLog(count uint32, bufSize int32, sources *Enum, types *Enum, ids *uint32, severities *Enum, lengths *int32, messageLog *uint8) uint32 {
+	r1, _, _ := syscall.Syscall9(proc(procGetDebugMessageLog), 8, uintptr(count), uintptr(bufSize), uintptr(unsafe.Pointer(sources)), uintptr(unsafe.Pointer(types)), uintptr(unsafe.Pointer(ids)), uintptr(unsafe.Pointer(severities)), uintptr(unsafe.Pointer(lengths)), uintptr(unsafe.Pointer(messageLog)), 0)
+	return uint32(r1)
+}
+
+// GetError calls glGetError (GL_ES_VERSION_2_0).
+func GetError() Enum {
+	r1, _, _ := syscall.Syscall(proc(procGetError), 0, 0, 0, 0)
+	return Enum(r1)
+}
+
+// GetFloatv calls glGetFloatv (GL_ES_VERSION_2_0).
+func GetFloatv(pname Enum, data *float32) {
+	syscall.Syscall(proc(procGetFloatv), 2, uintptr(pname), uintptr(unsafe.Pointer(data)), 0)
+}
+
+// GetFragDataLocation calls glGetFragDataLocation (GL_ES_VERSION_3_0).
+func GetFragDataLocation(program uint32, name string) int32 {
+	r1, _, _ := syscall.Syscall(proc(procGetFragDataLocation), 2, uintptr(program), uintptr(unsafe.Pointer(cString(name))), 0)
+	return int32(r1)
+}
+
+// GetFramebufferAttachmentParameteriv calls glGetFramebufferAttachmentParameteriv (GL_ES_VERSION_2_0).
+func GetFramebufferAttachmentParameteriv(target Enum, attachment Enum, pname Enum, params *int32) {
+	syscall.Syscall6(proc(procGetFramebufferAttachmentParameteriv), 4, uintptr(target), uintptr(attachment), uintptr(pname), uintptr(unsafe.Pointer(params)), 0, 0)
+}
+
+// GetFramebufferParameteriv calls glGetFramebufferParameteriv (GL_ES_VERSION_3_1).
+func GetFramebufferParameteriv(target Enum, pname Enum, params *int32) {
+	syscall.Syscall(proc(procGetFramebufferParameteriv), 3, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+}
+
+// GetGraphicsResetStatus calls glGetGraphicsResetStatus (GL_ES_VERSION_3_2).
+func GetGraphicsResetStatus() Enum {
+	r1, _, _ := syscall.Syscall(proc(procGetGraphicsResetStatus), 0, 0, 0, 0)
+	return Enum(r1)
+}
+
+// GetInteger64i_v calls glGetInteger64i_v (GL_ES_VERSION_3_0).
+func GetInteger64i_v(target Enum, index uint32, data *int64) {
+	syscall.Syscall(proc(procGetInteger64i_v), 3, uintptr(target), uintptr(index), uintptr(unsafe.Pointer(data)))
+}
+
+// GetInteger64v calls glGetInteger64v (GL_ES_VERSION_3_0).
+func GetInteger64v(pname Enum, data *int64) {
+	syscall.Syscall(proc(procGetInteger64v), 2, uintptr(pname), uintptr(unsafe.Pointer(data)), 0)
+}
+
+// GetIntegeri_v calls glGetIntegeri_v (GL_ES_VERSION_3_0).
+func GetIntegeri_v(target Enum, index uint32, data *int32) {
+	syscall.Syscall(proc(procGetIntegeri_v), 3, uintptr(target), uintptr(index), uintptr(unsafe.Pointer(data)))
+}
+
+// GetIntegerv calls glGetIntegerv (GL_ES_VERSION_2_0).
+func GetIntegerv(pname Enum, data *int32) {
+	syscall.Syscall(proc(procGetIntegerv), 2, uintptr(pname), uintptr(unsafe.Pointer(data)), 0)
+}
+
+// GetInternalformativ calls glGetInternalformativ (GL_ES_VERSION_3_0).
+func GetInternalformativ(target Enum, internalformat Enum, pname Enum, bufSize int32, params *int32) {
+	syscall.Syscall6(proc(procGetInternalformativ), 5, uintptr(target), uintptr(internalformat), uintptr(pname), uintptr(bufSize), uintptr(unsafe.Pointer(params)), 0)
+}
+
+// GetMultisamplefv calls glGetMultisamplefv (GL_ES_VERSION_3_1).
+func GetMultisamplefv(pname Enum, index uint32, val *float32) {
+	syscall.Syscall(proc(procGetMultisamplefv), 3, uintptr(pname), uintptr(index), uintptr(unsafe.Pointer(val)))
+}
+
+// GetObjectLabel calls glGetObjectLabel or glGetObjectLabelKHR (GL_ES_VERSION_3_2).
+func GetObjectLabel(identifier Enum, name uint32, bufSize int32, length *int32, label *uint8) {
+	syscall.Syscall6(proc(procGetObjectLabel), 5, uintptr(identifier), uintptr(name), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(label)), 0)
+}
+
+// GetObjectPtrLabel calls glGetObjectPtrLabel or glGetObjectPtrLabelKHR (GL_ES_VERSION_3_2).
+func GetObjectPtrLabel(ptr unsafe.Pointer, bufSize int32, length *int32, label *uint8) {
+	syscall.Syscall6(proc(procGetObjectPtrLabel), 4, uintptr(ptr), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(label)), 0, 0)
+}
+
+// GetPointerv calls glGetPointerv or glGetPointervKHR (GL_ES_VERSION_3_2).
+func GetPointerv(pname Enum, params *unsafe.Pointer) {
+	syscall.Syscall(proc(procGetPointerv), 2, uintptr(pname), uintptr(unsafe.Pointer(params)), 0)
+}
+
+// GetProgramBinary calls glGetProgramBinary (GL_ES_VERSION_3_0).
+func GetProgramBinary(program uint32, bufSize int32, length *int32, binaryFormat *Enum, binary unsafe.Pointer) {
+	syscall.Syscall6(proc(procGetProgramBinary), 5, uintptr(program), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(binaryFormat)), uintptr(binary), 0)
+}
+
+// GetProgramInfoLog calls glGetProgramInfoLog (GL_ES_VERSION_2_0).
+func GetProgramInfoLog(program uint32, bufSize int32, length *int32, infoLog *uint8) {
+	syscall.Syscall6(proc(procGetProgramInfoLog), 4, uintptr(program), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(infoLog)), 0, 0)
+}
+
+// GetProgramInterfaceiv calls glGetProgramInterfaceiv (GL_ES_VERSION_3_1).
+func GetProgramInterfaceiv(program uint32, programInterface Enum, pname Enum, params *int32) {
+	syscall.Syscall6(proc(procGetProgramInterfaceiv), 4, uintptr(program), uintptr(programInterface), uintptr(pname), uintptr(unsafe.Pointer(params)), 0, 0)
+}
+
+// GetProgramPipelineInfoLog calls glGetProgramPipelineInfoLog (GL_ES_VERSION_3_1).
+func GetProgramPipelineInfoLog(pipeline uint32, bufSize int32, length *int32, infoLog *uint8) {
+	syscall.Syscall6(proc(procGetProgramPipelineInfoLog), 4, uintptr(pipeline), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(infoLog)), 0, 0)
+}
+
+// GetProgramPipelineiv calls glGetProgramPipelineiv (GL_ES_VERSION_3_1).
+func GetProgramPipelineiv(pipeline uint32, pname Enum, params *int32) {
+	syscall.Syscall(proc(procGetProgramPipelineiv), 3, uintptr(pipeline), uintptr(pname), uintptr(unsafe.Pointer(params)))
+}
+
+// GetProgramResourceIndex calls glGetProgramResourceIndex (GL_ES_VERSION_3_1).
+func GetProgramResourceIndex(program uint32, programInterface Enum, name string) uint32 {
+	r1, _, _ := syscall.Syscall(proc(procGetProgramResourceIndex), 3, uintptr(program), uintptr(programInterface), uintptr(unsafe.Pointer(cString(name))))
+	return uint32(r1)
+}
+
+// GetProgramResourceLocation calls glGetProgramResourceLocation (GL_ES_VERSION_3_1).
+func GetProgramResourceLocation(program uint32, programInterface Enum, name string) int32 {
+	r1, _, _ := syscall.Syscall(proc(procGetProgramResourceLocation), 3, uintptr(program), uintptr(programInterface), uintptr(unsafe.Pointer(cString(name))))
+	return int32(r1)
+}
+
+// GetProgramResourceName calls glGetProgramResourceName (GL_ES_VERSION_3_1).
+func GetProgramResourceName(program uint32, programInterface Enum, index uint32, bufSize int32, length *int32, name *uint8) {
+	syscall.Syscall6(proc(procGetProgramResourceName), 6, uintptr(program), uintptr(programInterface), uintptr(index), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(name)))
+}
+
+// GetProgramResourceiv calls glGetProgramResourceiv (GL_ES_VERSION_3_1).
+func GetProgramResourceiv(program uint32, programInterface Enum, index uint32, propCount int32, props *Enum, bufSize int32, length *int32, params *int32) {
+	syscall.Syscall9(proc(procGetProgramResourceiv), 8, uintptr(program), uintptr(programInterface), uintptr(index), uintptr(propCount), uintptr(unsafe.Pointer(props)), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(params)), 0)
+}
+
+// GetProgramiv calls glGetProgramiv (GL_ES_VERSION_2_0).
+func GetProgramiv(program uint32, pname Enum, params *int32) {
+	syscall.Syscall(proc(procGetProgramiv), 3, uintptr(program), uintptr(pname), uintptr(unsafe.Pointer(params)))
+}
+
+// GetQueryObjectuiv calls glGetQueryObjectuiv (GL_ES_VERSION_3_0).
+func GetQueryObjectuiv(id uint32, pname Enum, params *uint32) {
+	syscall.Syscall(proc(procGetQueryObjectuiv), 3, uintptr(id), uintptr(pname), uintptr(unsafe.Pointer(params)))
+}
+
+// GetQueryiv calls glGetQueryiv (GL_ES_VERSION_3_0).
+func GetQueryiv(target Enum, pname Enum, params *int32) {
+	syscall.Syscall(proc(procGetQueryiv), 3, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+}
+
+// GetRenderbufferParameteriv calls glGetRenderbufferParameteriv (GL_ES_VERSION_2_0).
+func GetRenderbufferParameteriv(target Enum, pname Enum, params *int32) {
+	syscall.Syscall(proc(procGetRenderbufferParameteriv), 3, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+}
+
+// GetSamplerParameterIiv calls glGetSamplerParameterIiv (GL_ES_VERSION_3_2).
+func GetSamplerParameterIiv(sampler uint32, pname Enum, params *int32) {
+	syscall.Syscall(proc(procGetSamplerParameterIiv), 3, uintptr(sampler), uintptr(pname), uintptr(unsafe.Pointer(params)))
+}
+
+// GetSamplerParameterIuiv calls glGetSamplerParameterIuiv (GL_ES_VERSION_3_2).
+func GetSamplerParameterIuiv(sampler uint32, pname Enum, params *uint32) {
+	syscall.Syscall(proc(procGetSamplerParameterIuiv), 3, uintptr(sampler), uintptr(pname), uintptr(unsafe.Pointer(params)))
+}
+
+// GetSamplerParameterfv calls glGetSamplerParameterfv (GL_ES_VERSION_3_0).
+func GetSamplerParameterfv(sampler uint32, pname Enum, params *float32) {
+	syscall.Syscall(proc(procGetSamplerParameterfv), 3, uintptr(sampler), uintptr(pname), uintptr(unsafe.Pointer(params)))
+}
+
+// GetSamplerParameteriv calls glGetSamplerParameteriv (GL_ES_VERSION_3_0).
+func GetSamplerParameteriv(sampler uint32, pname Enum, params *int32) {
+	syscall.Syscall(proc(procGetSamplerParameteriv), 3, uintptr(sampler), uintptr(pname), uintptr(unsafe.Pointer(params)))
+}
+
+// GetShaderInfoLog calls glGetShaderInfoLog (GL_ES_VERSION_2_0).
+func GetShaderInfoLog(shader uint32, bufSize int32, length *int32, infoLog *uint8) {
+	syscall.Syscall6(proc(procGetShaderInfoLog), 4, uintptr(shader), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(infoLog)), 0, 0)
+}
+
+// GetShaderPrecisionFormat calls glGetShaderPrecisionFormat (GL_ES_VERSION_2_0).
+func GetShaderPrecisionFormat(shadertype Enum, precisiontype Enum, rng *int32, precision *int32) {
+	syscall.Syscall6(proc(procGetShaderPrecisionFormat), 4, uintptr(shadertype), uintptr(precisiontype), uintptr(unsafe.Pointer(rng)), uintptr(unsafe.Pointer(precision)), 0, 0)
+}
+
+// GetShaderSource calls glGetShaderSource (GL_ES_VERSION_2_0).
+func GetShaderSource(shader uint32, bufSize int32, length *int32, source *uint8) {
+	syscall.Syscall6(proc(procGetShaderSource), 4, uintptr(shader), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(source)), 0, 0)
+}
+
+// GetShaderiv calls glGetShaderiv (GL_ES_VERSION_2_0).
+func GetShaderiv(shader uint32, pname Enum, params *int32) {
+	syscall.Syscall(proc(procGetShaderiv), 3, uintptr(shader), uintptr(pname), uintptr(unsafe.Pointer(params)))
+}
+
+// GetString calls glGetString (GL_ES_VERSION_2_0).
+func GetString(name Enum) string {
+	r1, _, _ := syscall.Syscall(proc(procGetString), 1, uintptr(name), 0, 0)
+	return goString(r1)
+}
+
+// GetStringi calls glGetStringi (GL_ES_VERSION_3_0).
+func GetStringi(name Enum, index uint32) string {
+	r1, _, _ := syscall.Syscall(proc(procGetStringi), 2, uintptr(name), uintptr(index), 0)
+	return goString(r1)
+}
+
+// GetSynciv calls glGetSynciv (GL_ES_VERSION_3_0).
+func GetSynciv(sync uintptr, pname Enum, bufSize int32, length *int32, values *int32) {
+	syscall.Syscall6(proc(procGetSynciv), 5, uintptr(sync), uintptr(pname), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(values)), 0)
+}
+
+// GetTexLevelParameterfv calls glGetTexLevelParameterfv (GL_ES_VERSION_3_1).
+func GetTexLevelParameterfv(target Enum, level int32, pname Enum, params *float32) {
+	syscall.Syscall6(proc(procGetTexLevelParameterfv), 4, uintptr(target), uintptr(level), uintptr(pname), uintptr(unsafe.Pointer(params)), 0, 0)
+}
+
+// GetTexLevelParameteriv calls glGetTexLevelParameteriv (GL_ES_VERSION_3_1).
+func GetTexLevelParameteriv(target Enum, level int32, pname Enum, params *int32) {
+	syscall.Syscall6(proc(procGetTexLevelParameteriv), 4, uintptr(target), uintptr(level), uintptr(pname), uintptr(unsafe.Pointer(params)), 0, 0)
+}
+
+// GetTexParameterIiv calls glGetTexParameterIiv (GL_ES_VERSION_3_2).
+func GetTexParameterIiv(target Enum, pname Enum, params *int32) {
+	syscall.Syscall(proc(procGetTexParameterIiv), 3, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+}
+
+// GetTexParameterIuiv calls glGetTexParameterIuiv (GL_ES_VERSION_3_2).
+func GetTexParameterIuiv(target Enum, pname Enum, params *uint32) {
+	syscall.Syscall(proc(procGetTexParameterIuiv), 3, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+}
+
+// GetTexParameterfv calls glGetTexParameterfv (GL_ES_VERSION_2_0).
+func GetTexParameterfv(target Enum, pname Enum, params *float32) {
+	syscall.Syscall(proc(procGetTexParameterfv), 3, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+}
+
+// GetTexParameteriv calls glGetTexParameteriv (GL_ES_VERSION_2_0).
+func GetTexParameteriv(target Enum, pname Enum, params *int32) {
+	syscall.Syscall(proc(procGetTexParameteriv), 3, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+}
+
+// GetTransformFeedbackVarying calls glGetTransformFeedbackVarying (GL_ES_VERSION_3_0).
+func GetTransformFeedbackVarying(program uint32, index uint32, bufSize int32, length *int32, size *int32, typ *Enum, name *uint8) {
+	syscall.Syscall9(proc(procGetTransformFeedbackVarying), 7, uintptr(program), uintptr(index), uintptr(bufSize), uintptr(unsafe.Pointer(length)), uintptr(unsafe.Pointer(size)), uintptr(unsafe.Pointer(typ)), uintptr(unsafe.Pointer(name)), 0, 0)
+}
+
+// GetUniformBlockIndex calls glGetUniformBlockIndex (GL_ES_VERSION_3_0).
+func GetUniformBlockIndex(program uint32, uniformBlockName string) uint32 {
+	r1, _, _ := syscall.Syscall(proc(procGetUniformBlockIndex), 2, uintptr(program), uintptr(unsafe.Pointer(cString(uniformBlockName))), 0)
+	return uint32(r1)
+}
+
+// GetUniformIndices calls glGetUniformIndices (GL_ES_VERSION_3_0).
+func GetUniformIndices(program uint32, uniformCount int32, uniformNames **uint8, uniformIndices *uint32) {
+	syscall.Syscall6(proc(procGetUniformIndices), 4, uintptr(program), uintptr(uniformCount), uintptr(unsafe.Pointer(uniformNames)), uintptr(unsafe.Pointer(uniformIndices)), 0, 0)
+}
+
+// GetUniformLocation calls glGetUniformLocation (GL_ES_VERSION_2_0).
+func GetUniformLocation(program uint32, name string) int32 {
+	r1, _, _ := syscall.Syscall(proc(procGetUniformLocation), 2, uintptr(program), uintptr(unsafe.Pointer(cString(name))), 0)
+	return int32(r1)
+}
+
+// GetUniformfv calls glGetUniformfv (GL_ES_VERSION_2_0).
+func GetUniformfv(program uint32, location int32, params *float32) {
+	syscall.Syscall(proc(procGetUniformfv), 3, uintptr(program), uintptr(location), uintptr(unsafe.Pointer(params)))
+}
+
+// GetUniformiv calls glGetUniformiv (GL_ES_VERSION_2_0).
+func GetUniformiv(program uint32, location int32, params *int32) {
+	syscall.Syscall(proc(procGetUniformiv), 3, uintptr(program), uintptr(location), uintptr(unsafe.Pointer(params)))
+}
+
+// GetUniformuiv calls glGetUniformuiv (GL_ES_VERSION_3_0).
+func GetUniformuiv(program uint32, location int32, params *uint32) {
+	syscall.Syscall(proc(procGetUniformuiv), 3, uintptr(program), uintptr(location), uintptr(unsafe.Pointer(params)))
+}
+
+// GetVertexAttribIiv calls glGetVertexAttribIiv (GL_ES_VERSION_3_0).
+func GetVertexAttribIiv(index uint32, pname Enum, params *int32) {
+	syscall.Syscall(proc(procGetVertexAttribIiv), 3, uintptr(index), uintptr(pname), uintptr(unsafe.Pointer(params)))
+}
+
+// GetVertexAttribIuiv calls glGetVertexAttribIuiv (GL_ES_VERSION_3_0).
+func GetVertexAttribIuiv(index uint32, pname Enum, params *uint32) {
+	syscall.Syscall(proc(procGetVertexAttribIuiv), 3, uintptr(index), uintptr(pname), uintptr(unsafe.Pointer(params)))
+}
+
+// GetVertexAttribPointerv calls glGetVertexAttribPointerv (GL_ES_VERSION_2_0).
+func GetVertexAttribPointerv(index uint32, pname Enum, pointer *unsafe.Pointer) {
+	syscall.Syscall(proc(procGetVertexAttribPointerv), 3, uintptr(index), uintptr(pname), uintptr(unsafe.Pointer(pointer)))
+}
+
+// GetVertexAttribfv calls glGetVertexAttribfv (GL_ES_VERSION_2_0).
+func GetVertexAttribfv(index uint32, pname Enum, params *float32) {
+	syscall.Syscall(proc(procGetVertexAttribfv), 3, uintptr(index), uintptr(pname), uintptr(unsafe.Pointer(params)))
+}
+
+// GetVertexAttribiv calls glGetVertexAttribiv (GL_ES_VERSION_2_0).
+func GetVertexAttribiv(index uint32, pname Enum, params *int32) {
+	syscall.Syscall(proc(procGetVertexAttribiv), 3, uintptr(index), uintptr(pname), uintptr(unsafe.Pointer(params)))
+}
+
+// GetnUniformfv calls glGetnUniformfv (GL_ES_VERSION_3_2).
+func GetnUniformfv(program uint32, location int32, bufSize int32, params *float32) {
+	syscall.Syscall6(proc(procGetnUniformfv), 4, uintptr(program), uintptr(location), uintptr(bufSize), uintptr(unsafe.Pointer(params)), 0, 0)
+}
+
+// GetnUniformiv calls glGetnUniformiv (GL_ES_VERSION_3_2).
+func GetnUniformiv(program uint32, location int32, bufSize int32, params *int32) {
+	syscall.Syscall6(proc(procGetnUniformiv), 4, uintptr(program), uintptr(location), uintptr(bufSize), uintptr(unsafe.Pointer(params)), 0, 0)
+}
+
+// GetnUniformuiv calls glGetnUniformuiv (GL_ES_VERSION_3_2).
+func GetnUniformuiv(program uint32, location int32, bufSize int32, params *uint32) {
+	syscall.Syscall6(proc(procGetnUniformuiv), 4, uintptr(program), uintptr(location), uintptr(bufSize), uintptr(unsafe.Pointer(params)), 0, 0)
+}
+
+// Hint calls glHint (GL_ES_VERSION_2_0).
+func Hint(target Enum, mode Enum) {
+	syscall.Syscall(proc(procHint), 2, uintptr(target), uintptr(mode), 0)
+}
+
+// InvalidateFramebuffer calls glInvalidateFramebuffer (GL_ES_VERSION_3_0).
+func InvalidateFramebuffer(target Enum, numAttachments int32, attachments *Enum) {
+	syscall.Syscall(proc(procInvalidateFramebuffer), 3, uintptr(target), uintptr(numAttachments), uintptr(unsafe.Pointer(attachments)))
+}
+
+// InvalidateSubFramebuffer calls glInvalidateSubFramebuffer (GL_ES_VERSION_3_0).
+func InvalidateSubFramebuffer(target Enum, numAttachments int32, attachments *Enum, x int32, y int32, width int32, height int32) {
+	syscall.Syscall9(proc(procInvalidateSubFramebuffer), 7, uintptr(target), uintptr(numAttachments), uintptr(unsafe.Pointer(attachments)), uintptr(x), uintptr(y), uintptr(width), uintptr(height), 0, 0)
+}
+
+// IsBuffer calls glIsBuffer (GL_ES_VERSION_2_0).
+func IsBuffer(buffer uint32) bool {
+	r1, _, _ := syscall.Syscall(proc(procIsBuffer), 1, uintptr(buffer), 0, 0)
+	return uint8(r1) != 0
+}
+
+// IsEnabled calls glIsEnabled (GL_ES_VERSION_2_0).
+func IsEnabled(cap Enum) bool {
+	r1, _, _ := syscall.Syscall(proc(procIsEnabled), 1, uintptr(cap), 0, 0)
+	return uint8(r1) != 0
+}
+
+// IsEnabledi calls glIsEnabledi (GL_ES_VERSION_3_2).
+func IsEnabledi(target Enum, index uint32) bool {
+	r1, _, _ := syscall.Syscall(proc(procIsEnabledi), 2, uintptr(target), uintptr(index), 0)
+	return uint8(r1) != 0
+}
+
+// IsFramebuffer calls glIsFramebuffer (GL_ES_VERSION_2_0).
+func IsFramebuffer(framebuffer uint32) bool {
+	r1, _, _ := syscall.Syscall(proc(procIsFramebuffer), 1, uintptr(framebuffer), 0, 0)
+	return uint8(r1) != 0
+}
+
+// IsProgram calls glIsProgram (GL_ES_VERSION_2_0).
+func IsProgram(program uint32) bool {
+	r1, _, _ := syscall.Syscall(proc(procIsProgram), 1, uintptr(program), 0, 0)
+	return uint8(r1) != 0
+}
+
+// IsProgramPipeline calls glIsProgramPipeline (GL_ES_VERSION_3_1).
+func IsProgramPipeline(pipeline uint32) bool {
+	r1, _, _ := syscall.Syscall(proc(procIsProgramPipeline), 1, uintptr(pipeline), 0, 0)
+	return uint8(r1) != 0
+}
+
+// IsQuery calls glIsQuery (GL_ES_VERSION_3_0).
+func IsQuery(id uint32) bool {
+	r1, _, _ := syscall.Syscall(proc(procIsQuery), 1, uintptr(id), 0, 0)
+	return uint8(r1) != 0
+}
+
+// IsRenderbuffer calls glIsRenderbuffer (GL_ES_VERSION_2_0).
+func IsRenderbuffer(renderbuffer uint32) bool {
+	r1, _, _ := syscall.Syscall(proc(procIsRenderbuffer), 1, uintptr(renderbuffer), 0, 0)
+	return uint8(r1) != 0
+}
+
+// IsSampler calls glIsSampler (GL_ES_VERSION_3_0).
+func IsSampler(sampler uint32) bool {
+	r1, _, _ := syscall.Syscall(proc(procIsSampler), 1, uintptr(sampler), 0, 0)
+	return uint8(r1) != 0
+}
+
+// IsShader calls glIsShader (GL_ES_VERSION_2_0).
+func IsShader(shader uint32) bool {
+	r1, _, _ := syscall.Syscall(proc(procIsShader), 1, uintptr(shader), 0, 0)
+	return uint8(r1) != 0
+}
+
+// IsSync calls glIsSync (GL_ES_VERSION_3_0).
+func IsSync(sync uintptr) bool {
+	r1, _, _ := syscall.Syscall(proc(procIsSync), 1, uintptr(sync), 0, 0)
+	return uint8(r1) != 0
+}
+
+// IsTexture calls glIsTexture (GL_ES_VERSION_2_0).
+func IsTexture(texture uint32) bool {
+	r1, _, _ := syscall.Syscall(proc(procIsTexture), 1, uintptr(texture), 0, 0)
+	return uint8(r1) != 0
+}
+
+// IsTransformFeedback calls glIsTransformFeedback (GL_ES_VERSION_3_0).
+func IsTransformFeedback(id uint32) bool {
+	r1, _, _ := syscall.Syscall(proc(procIsTransformFeedback), 1, uintptr(id), 0, 0)
+	return uint8(r1) != 0
+}
+
+// IsVertexArray calls glIsVertexArray (GL_ES_VERSION_3_0).
+func IsVertexArray(array uint32) bool {
+	r1, _, _ := syscall.Syscall(proc(procIsVertexArray), 1, uintptr(array), 0, 0)
+	return uint8(r1) != 0
+}
+
+// LineWidth calls glLineWidth (GL_ES_VERSION_2_0).
+//
+// It is not supported on windows/arm64: floating-point arguments are not passed by the syscall package.
+func LineWidth(width float32) {
+	panic("gl: glLineWidth is not supported on windows/arm64")
+}
+
+// LinkProgram calls glLinkProgram (GL_ES_VERSION_2_0).
+func LinkProgram(program uint32) {
+	syscall.Syscall(proc(procLinkProgram), 1, uintptr(program), 0, 0)
+}
+
+// MapBufferRange calls glMapBufferRange (GL_ES_VERSION_3_0).
+func MapBufferRange(target Enum, offset int, length int, access uint32) unsafe.Pointer {
+	r1, _, _ := syscall.Syscall6(proc(procMapBufferRange), 4, uintptr(target), uintptr(offset), uintptr(length), uintptr(access), 0, 0)
+	return *(*unsafe.Pointer)(unsafe.Pointer(&r1))
+}
+
+// MemoryBarrier calls glMemoryBarrier (GL_ES_VERSION_3_1).
+func MemoryBarrier(barriers uint32) {
+	syscall.Syscall(proc(procMemoryBarrier), 1, uintptr(barriers), 0, 0)
+}
+
+// MemoryBarrierByRegion calls glMemoryBarrierByRegion (GL_ES_VERSION_3_1).
+func MemoryBarrierByRegion(barriers uint32) {
+	syscall.Syscall(proc(procMemoryBarrierByRegion), 1, uintptr(barriers), 0, 0)
+}
+
+// MinSampleShading calls glMinSampleShading (GL_ES_VERSION_3_2).
+//
+// It is not supported on windows/arm64: floating-point arguments are not passed by the syscall package.
+func MinSampleShading(value float32) {
+	panic("gl: glMinSampleShading is not supported on windows/arm64")
+}
+
+// ObjectLabel calls glObjectLabel or glObjectLabelKHR (GL_ES_VERSION_3_2).
+func ObjectLabel(identifier Enum, name uint32, length int32, label string) {
+	syscall.Syscall6(proc(procObjectLabel), 4, uintptr(identifier), uintptr(name), uintptr(length), uintptr(unsafe.Pointer(cString(label))), 0, 0)
+}
+
+// ObjectPtrLabel calls glObjectPtrLabel or glObjectPtrLabelKHR (GL_ES_VERSION_3_2).
+func ObjectPtrLabel(ptr unsafe.Pointer, length int32, label string) {
+	syscall.Syscall(proc(procObjectPtrLabel), 3, uintptr(ptr), uintptr(length), uintptr(unsafe.Pointer(cString(label))))
+}
+
+// PatchParameteri calls glPatchParameteri (GL_ES_VERSION_3_2).
+func PatchParameteri(pname Enum, value int32) {
+	syscall.Syscall(proc(procPatchParameteri), 2, uintptr(pname), uintptr(value), 0)
+}
+
+// PauseTransformFeedback calls glPauseTransformFeedback (GL_ES_VERSION_3_0).
+func PauseTransformFeedback() {
+	syscall.Syscall(proc(procPauseTransformFeedback), 0, 0, 0, 0)
+}
+
+// PixelStorei calls glPixelStorei (GL_ES_VERSION_2_0).
+func PixelStorei(pname Enum, param int32) {
+	syscall.Syscall(proc(procPixelStorei), 2, uintptr(pname), uintptr(param), 0)
+}
+
+// PolygonOffset calls glPolygonOffset (GL_ES_VERSION_2_0).
+//
+// It is not supported on windows/arm64: floating-point arguments are not passed by the syscall package.
+func PolygonOffset(factor float32, units float32) {
+	panic("gl: glPolygonOffset is not supported on windows/arm64")
+}
+
+// PopDebugGroup calls glPopDebugGroup or glPopDebugGroupKHR (GL_ES_VERSION_3_2).
+func PopDebugGroup() {
+	syscall.Syscall(proc(procPopDebugGroup), 0, 0, 0, 0)
+}
+
+// PrimitiveBoundingBox calls glPrimitiveBoundingBox (GL_ES_VERSION_3_2).
+//
+// It is not supported on windows/arm64: floating-point arguments are not passed by the syscall package.
+func PrimitiveBoundingBox(minX float32, minY float32, minZ float32, minW float32, maxX float32, maxY float32, maxZ float32, maxW float32) {
+	panic("gl: glPrimitiveBoundingBox is not supported on windows/arm64")
+}
+
+// ProgramBinary calls glProgramBinary (GL_ES_VERSION_3_0).
+func ProgramBinary(program uint32, binaryFormat Enum, binary unsafe.Pointer, length int32) {
+	syscall.Syscall6(proc(procProgramBinary), 4, uintptr(program), uintptr(binaryFormat), uintptr(binary), uintptr(length), 0, 0)
+}
+
+// ProgramParameteri calls glProgramParameteri (GL_ES_VERSION_3_0).
+func ProgramParameteri(program uint32, pname Enum, value int32) {
+	syscall.Syscall(proc(procProgramParameteri), 3, uintptr(program), uintptr(pname), uintptr(value))
+}
+
+// ProgramUniform1f calls glProgramUniform1f (GL_ES_VERSION_3_1).
+//
+// It is not supported on windows/arm64: floating-point arguments are not passed by the syscall package.
+func ProgramUniform1f(program uint32, location int32, v0 float32) {
+	panic("gl: glProgramUniform1f is not supported on windows/arm64")
+}
+
+// ProgramUniform1fv calls glProgramUniform1fv (GL_ES_VERSION_3_1).
+func ProgramUniform1fv(program uint32, location int32, count int32, value *float32) {
+	syscall.Syscall6(proc(procProgramUniform1fv), 4, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)), 0, 0)
+}
+
+// ProgramUniform1i calls glProgramUniform1i (GL_ES_VERSION_3_1).
+func ProgramUniform1i(program uint32, location int32, v0 int32) {
+	syscall.Syscall(proc(procProgramUniform1i), 3, uintptr(program), uintptr(location), uintptr(v0))
+}
+
+// ProgramUniform1iv calls glProgramUniform1iv (GL_ES_VERSION_3_1).
+func ProgramUniform1iv(program uint32, location int32, count int32, value *int32) {
+	syscall.Syscall6(proc(procProgramUniform1iv), 4, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)), 0, 0)
+}
+
+// ProgramUniform1ui calls glProgramUniform1ui (GL_ES_VERSION_3_1).
+func ProgramUniform1ui(program uint32, location int32, v0 uint32) {
+	syscall.Syscall(proc(procProgramUniform1ui), 3, uintptr(program), uintptr(location), uintptr(v0))
+}
+
+// ProgramUniform1uiv calls glProgramUniform1uiv (GL_ES_VERSION_3_1).
+func ProgramUniform1uiv(program uint32, location int32, count int32, value *uint32) {
+	syscall.Syscall6(proc(procProgramUniform1uiv), 4, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)), 0, 0)
+}
+
+// ProgramUniform2f calls glProgramUniform2f (GL_ES_VERSION_3_1).
+//
+// It is not supported on windows/arm64: floating-point arguments are not passed by the syscall package.
+func ProgramUniform2f(program uint32, location int32, v0 float32, v1 float32) {
+	panic("gl: glProgramUniform2f is not supported on windows/arm64")
+}
+
+// ProgramUniform2fv calls glProgramUniform2fv (GL_ES_VERSION_3_1).
+func ProgramUniform2fv(program uint32, location int32, count int32, value *float32) {
+	syscall.Syscall6(proc(procProgramUniform2fv), 4, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)), 0, 0)
+}
+
+// ProgramUniform2i calls glProgramUniform2i (GL_ES_VERSION_3_1).
+func ProgramUniform2i(program uint32, location int32, v0 int32, v1 int32) {
+	syscall.Syscall6(proc(procProgramUniform2i), 4, uintptr(program), uintptr(location), uintptr(v0), uintptr(v1), 0, 0)
+}
+
+// ProgramUniform2iv calls glProgramUniform2iv (GL_ES_VERSION_3_1).
+func ProgramUniform2iv(program uint32, location int32, count int32, value *int32) {
+	syscall.Syscall6(proc(procProgramUniform2iv), 4, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)), 0, 0)
+}
+
+// ProgramUniform2ui calls glProgramUniform2ui (GL_ES_VERSION_3_1).
+func ProgramUniform2ui(program uint32, location int32, v0 uint32, v1 uint32) {
+	syscall.Syscall6(proc(procProgramUniform2ui), 4, uintptr(program), uintptr(location), uintptr(v0), uintptr(v1), 0, 0)
+}
+
+// ProgramUniform2uiv calls glProgramUniform2uiv (GL_ES_VERSION_3_1).
+func ProgramUniform2uiv(program uint32, location int32, count int32, value *uint32) {
+	syscall.Syscall6(proc(procProgramUniform2uiv), 4, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)), 0, 0)
+}
+
+// ProgramUniform3f calls glProgramUniform3f (GL_ES_VERSION_3_1).
+//
+// It is not supported on windows/arm64: floating-point arguments are not passed by the syscall package.
+func ProgramUniform3f(program uint32, location int32, v0 float32, v1 float32, v2 float32) {
+	panic("gl: glProgramUniform3f is not supported on windows/arm64")
+}
+
+// ProgramUniform3fv calls glProgramUniform3fv (GL_ES_VERSION_3_1).
+func ProgramUniform3fv(program uint32, location int32, count int32, value *float32) {
+	syscall.Syscall6(proc(procProgramUniform3fv), 4, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)), 0, 0)
+}
+
+// ProgramUniform3i calls glProgramUniform3i (GL_ES_VERSION_3_1).
+func ProgramUniform3i(program uint32, location int32, v0 int32, v1 int32, v2 int32) {
+	syscall.Syscall6(proc(procProgramUniform3i), 5, uintptr(program), uintptr(location), uintptr(v0), uintptr(v1), uintptr(v2), 0)
+}
+
+// ProgramUniform3iv calls glProgramUniform3iv (GL_ES_VERSION_3_1).
+func ProgramUniform3iv(program uint32, location int32, count int32, value *int32) {
+	syscall.Syscall6(proc(procProgramUniform3iv), 4, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)), 0, 0)
+}
+
+// ProgramUniform3ui calls glProgramUniform3ui (GL_ES_VERSION_3_1).
+func ProgramUniform3ui(program uint32, location int32, v0 uint32, v1 uint32, v2 uint32) {
+	syscall.Syscall6(proc(procProgramUniform3ui), 5, uintptr(program), uintptr(location), uintptr(v0), uintptr(v1), uintptr(v2), 0)
+}
+
+// ProgramUniform3uiv calls glProgramUniform3uiv (GL_ES_VERSION_3_1).
+func ProgramUniform3uiv(program uint32, location int32, count int32, value *uint32) {
+	syscall.Syscall6(proc(procProgramUniform3uiv), 4, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)), 0, 0)
+}
+
+// ProgramUniform4f calls glProgramUniform4f (GL_ES_VERSION_3_1).
+//
+// It is not supported on windows/arm64: floating-point arguments are not passed by the syscall package.
+func ProgramUniform4f(program uint32, location int32, v0 float32, v1 float32, v2 float32, v3 float32) {
+	panic("gl: glProgramUniform4f is not supported on windows/arm64")
+}
+
+// ProgramUniform4fv calls glProgramUniform4fv (GL_ES_VERSION_3_1).
+func ProgramUniform4fv(program uint32, location int32, count int32, value *float32) {
+	syscall.Syscall6(proc(procProgramUniform4fv), 4, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)), 0, 0)
+}
+
+// ProgramUniform4i calls glProgramUniform4i (GL_ES_VERSION_3_1).
+func ProgramUniform4i(program uint32, location int32, v0 int32, v1 int32, v2 int32, v3 int32) {
+	syscall.Syscall6(proc(procProgramUniform4i), 6, uintptr(program), uintptr(location), uintptr(v0), uintptr(v1), uintptr(v2), uintptr(v3))
+}
+
+// ProgramUniform4iv calls glProgramUniform4iv (GL_ES_VERSION_3_1).
+func ProgramUniform4iv(program uint32, location int32, count int32, value *int32) {
+	syscall.Syscall6(proc(procProgramUniform4iv), 4, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)), 0, 0)
+}
+
+// ProgramUniform4ui calls glProgramUniform4ui (GL_ES_VERSION_3_1).
+func ProgramUniform4ui(program uint32, location int32, v0 uint32, v1 uint32, v2 uint32, v3 uint32) {
+	syscall.Syscall6(proc(procProgramUniform4ui), 6, uintptr(program), uintptr(location), uintptr(v0), uintptr(v1), uintptr(v2), uintptr(v3))
+}
+
+// ProgramUniform4uiv calls glProgramUniform4uiv (GL_ES_VERSION_3_1).
+func ProgramUniform4uiv(program uint32, location int32, count int32, value *uint32) {
+	syscall.Syscall6(proc(procProgramUniform4uiv), 4, uintptr(program), uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)), 0, 0)
+}
+
+// ProgramUniformMatrix2fv calls glProgramUniformMatrix2fv (GL_ES_VERSION_3_1).
+func ProgramUniformMatrix2fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(proc(procProgramUniformMatrix2fv), 5, uintptr(program), uintptr(location), uintptr(count), boolArg(transpose), uintptr(unsafe.Pointer(value)), 0)
+}
+
+// ProgramUniformMatrix2x3fv calls glProgramUniformMatrix2x3fv (GL_ES_VERSION_3_1).
+func ProgramUniformMatrix2x3fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(proc(procProgramUniformMatrix2x3fv), 5, uintptr(program), uintptr(location), uintptr(count), boolArg(transpose), uintptr(unsafe.Pointer(value)), 0)
+}
+
+// ProgramUniformMatrix2x4fv calls glProgramUniformMatrix2x4fv (GL_ES_VERSION_3_1).
+func ProgramUniformMatrix2x4fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(proc(procProgramUniformMatrix2x4fv), 5, uintptr(program), uintptr(location), uintptr(count), boolArg(transpose), uintptr(unsafe.Pointer(value)), 0)
+}
+
+// ProgramUniformMatrix3fv calls glProgramUniformMatrix3fv (GL_ES_VERSION_3_1).
+func ProgramUniformMatrix3fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(proc(procProgramUniformMatrix3fv), 5, uintptr(program), uintptr(location), uintptr(count), boolArg(transpose), uintptr(unsafe.Pointer(value)), 0)
+}
+
+// ProgramUniformMatrix3x2fv calls glProgramUniformMatrix3x2fv (GL_ES_VERSION_3_1).
+func ProgramUniformMatrix3x2fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(proc(procProgramUniformMatrix3x2fv), 5, uintptr(program), uintptr(location), uintptr(count), boolArg(transpose), uintptr(unsafe.Pointer(value)), 0)
+}
+
+// ProgramUniformMatrix3x4fv calls glProgramUniformMatrix3x4fv (GL_ES_VERSION_3_1).
+func ProgramUniformMatrix3x4fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(proc(procProgramUniformMatrix3x4fv), 5, uintptr(program), uintptr(location), uintptr(count), boolArg(transpose), uintptr(unsafe.Pointer(value)), 0)
+}
+
+// ProgramUniformMatrix4fv calls glProgramUniformMatrix4fv (GL_ES_VERSION_3_1).
+func ProgramUniformMatrix4fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(proc(procProgramUniformMatrix4fv), 5, uintptr(program), uintptr(location), uintptr(count), boolArg(transpose), uintptr(unsafe.Pointer(value)), 0)
+}
+
+// ProgramUniformMatrix4x2fv calls glProgramUniformMatrix4x2fv (GL_ES_VERSION_3_1).
+func ProgramUniformMatrix4x2fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(proc(procProgramUniformMatrix4x2fv), 5, uintptr(program), uintptr(location), uintptr(count), boolArg(transpose), uintptr(unsafe.Pointer(value)), 0)
+}
+
+// ProgramUniformMatrix4x3fv calls glProgramUniformMatrix4x3fv (GL_ES_VERSION_3_1).
+func ProgramUniformMatrix4x3fv(program uint32, location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(proc(procProgramUniformMatrix4x3fv), 5, uintptr(program), uintptr(location), uintptr(count), boolArg(transpose), uintptr(unsafe.Pointer(value)), 0)
+}
+
+// PushDebugGroup calls glPushDebugGroup or glPushDebugGroupKHR (GL_ES_VERSION_3_2).
+func PushDebugGroup(source Enum, id uint32, length int32, message string) {
+	syscall.Syscall6(proc(procPushDebugGroup), 4, uintptr(source), uintptr(id), uintptr(length), uintptr(unsafe.Pointer(cString(message))), 0, 0)
+}
+
+// ReadBuffer calls glReadBuffer (GL_ES_VERSION_3_0).
+func ReadBuffer(src Enum) {
+	syscall.Syscall(proc(procReadBuffer), 1, uintptr(src), 0, 0)
+}
+
+// ReadPixels calls glReadPixels (GL_ES_VERSION_2_0).
+func ReadPixels(x int32, y int32, width int32, height int32, format Enum, typ Enum, pixels unsafe.Pointer) {
+	syscall.Syscall9(proc(procReadPixels), 7, uintptr(x), uintptr(y), uintptr(width), uintptr(height), uintptr(format), uintptr(typ), uintptr(pixels), 0, 0)
+}
+
+// ReadnPixels calls glReadnPixels (GL_ES_VERSION_3_2).
+func ReadnPixels(x int32, y int32, width int32, height int32, format Enum, typ Enum, bufSize int32, data unsafe.Pointer) {
+	syscall.Syscall9(proc(procReadnPixels), 8, uintptr(x), uintptr(y), uintptr(width), uintptr(height), uintptr(format), uintptr(typ), uintptr(bufSize), uintptr(data), 0)
+}
+
+// ReleaseShaderCompiler calls glReleaseShaderCompiler (GL_ES_VERSION_2_0).
+func ReleaseShaderCompiler() {
+	syscall.Syscall(proc(procReleaseShaderCompiler), 0, 0, 0, 0)
+}
+
+// RenderbufferStorage calls glRenderbufferStorage (GL_ES_VERSION_2_0).
+func RenderbufferStorage(target Enum, internalformat Enum, width int32, height int32) {
+	syscall.Syscall6(proc(procRenderbufferStorage), 4, uintptr(target), uintptr(internalformat), uintptr(width), uintptr(height), 0, 0)
+}
+
+// RenderbufferStorageMultisample calls glRenderbufferStorageMultisample (GL_ES_VERSION_3_0).
+func RenderbufferStorageMultisample(target Enum, samples int32, internalformat Enum, width int32, height int32) {
+	syscall.Syscall6(proc(procRenderbufferStorageMultisample), 5, uintptr(target), uintptr(samples), uintptr(internalformat), uintptr(width), uintptr(height), 0)
+}
+
+// ResumeTransformFeedback calls glResumeTransformFeedback (GL_ES_VERSION_3_0).
+func ResumeTransformFeedback() {
+	syscall.Syscall(proc(procResumeTransformFeedback), 0, 0, 0, 0)
+}
+
+// SampleCoverage calls glSampleCoverage (GL_ES_VERSION_2_0).
+//
+// It is not supported on windows/arm64: floating-point arguments are not passed by the syscall package.
+func SampleCoverage(value float32, invert bool) {
+	panic("gl: glSampleCoverage is not supported on windows/arm64")
+}
+
+// SampleMaski calls glSampleMaski (GL_ES_VERSION_3_1).
+func SampleMaski(maskNumber uint32, mask uint32) {
+	syscall.Syscall(proc(procSampleMaski), 2, uintptr(maskNumber), uintptr(mask), 0)
+}
+
+// SamplerParameterIiv calls glSamplerParameterIiv (GL_ES_VERSION_3_2).
+func SamplerParameterIiv(sampler uint32, pname Enum, param *int32) {
+	syscall.Syscall(proc(procSamplerParameterIiv), 3, uintptr(sampler), uintptr(pname), uintptr(unsafe.Pointer(param)))
+}
+
+// SamplerParameterIuiv calls glSamplerParameterIuiv (GL_ES_VERSION_3_2).
+func SamplerParameterIuiv(sampler uint32, pname Enum, param *uint32) {
+	syscall.Syscall(proc(procSamplerParameterIuiv), 3, uintptr(sampler), uintptr(pname), uintptr(unsafe.Pointer(param)))
+}
+
+// SamplerParameterf calls glSamplerParameterf (GL_ES_VERSION_3_0).
+//
+// It is not supported on windows/arm64: floating-point arguments are not passed by the syscall package.
+func SamplerParameterf(sampler uint32, pname Enum, param float32) {
+	panic("gl: glSamplerParameterf is not supported on windows/arm64")
+}
+
+// SamplerParameterfv calls glSamplerParameterfv (GL_ES_VERSION_3_0).
+func SamplerParameterfv(sampler uint32, pname Enum, param *float32) {
+	syscall.Syscall(proc(procSamplerParameterfv), 3, uintptr(sampler), uintptr(pname), uintptr(unsafe.Pointer(param)))
+}
+
+// SamplerParameteri calls glSamplerParameteri (GL_ES_VERSION_3_0).
+func SamplerParameteri(sampler uint32, pname Enum, param int32) {
+	syscall.Syscall(proc(procSamplerParameteri), 3, uintptr(sampler), uintptr(pname), uintptr(param))
+}
+
+// SamplerParameteriv calls glSamplerParameteriv (GL_ES_VERSION_3_0).
+func SamplerParameteriv(sampler uint32, pname Enum, param *int32) {
+	syscall.Syscall(proc(procSamplerParameteriv), 3, uintptr(sampler), uintptr(pname), uintptr(unsafe.Pointer(param)))
+}
+
+// Scissor calls glScissor (GL_ES_VERSION_2_0).
+func Scissor(x int32, y int32, width int32, height int32) {
+	syscall.Syscall6(proc(procScissor), 4, uintptr(x), uintptr(y), uintptr(width), uintptr(height), 0, 0)
+}
+
+// ShaderBinary calls glShaderBinary (GL_ES_VERSION_2_0).
+func ShaderBinary(count int32, shaders *uint32, binaryformat Enum, binary unsafe.Pointer, length int32) {
+	syscall.Syscall6(proc(procShaderBinary), 5, uintptr(count), uintptr(unsafe.Pointer(shaders)), uintptr(binaryformat), uintptr(binary), uintptr(length), 0)
+}
+
+// ShaderSource calls glShaderSource (GL_ES_VERSION_2_0).
+func ShaderSource(shader uint32, count int32, str **uint8, length *int32) {
+	syscall.Syscall6(proc(procShaderSource), 4, uintptr(shader), uintptr(count), uintptr(unsafe.Pointer(str)), uintptr(unsafe.Pointer(length)), 0, 0)
+}
+
+// StencilFunc calls glStencilFunc (GL_ES_VERSION_2_0).
+func StencilFunc(fn Enum, ref int32, mask uint32) {
+	syscall.Syscall(proc(procStencilFunc), 3, uintptr(fn), uintptr(ref), uintptr(mask))
+}
+
+// StencilFuncSeparate calls glStencilFuncSeparate (GL_ES_VERSION_2_0).
+func StencilFuncSeparate(face Enum, fn Enum, ref int32, mask uint32) {
+	syscall.Syscall6(proc(procStencilFuncSeparate), 4, uintptr(face), uintptr(fn), uintptr(ref), uintptr(mask), 0, 0)
+}
+
+// StencilMask calls glStencilMask (GL_ES_VERSION_2_0).
+func StencilMask(mask uint32) {
+	syscall.Syscall(proc(procStencilMask), 1, uintptr(mask), 0, 0)
+}
+
+// StencilMaskSeparate calls glStencilMaskSeparate (GL_ES_VERSION_2_0).
+func StencilMaskSeparate(face Enum, mask uint32) {
+	syscall.Syscall(proc(procStencilMaskSeparate), 2, uintptr(face), uintptr(mask), 0)
+}
+
+// StencilOp calls glStencilOp (GL_ES_VERSION_2_0).
+func StencilOp(fail Enum, zfail Enum, zpass Enum) {
+	syscall.Syscall(proc(procStencilOp), 3, uintptr(fail), uintptr(zfail), uintptr(zpass))
+}
+
+// StencilOpSeparate calls glStencilOpSeparate (GL_ES_VERSION_2_0).
+func StencilOpSeparate(face Enum, sfail Enum, dpfail Enum, dppass Enum) {
+	syscall.Syscall6(proc(procStencilOpSeparate), 4, uintptr(face), uintptr(sfail), uintptr(dpfail), uintptr(dppass), 0, 0)
+}
+
+// TexBuffer calls glTexBuffer (GL_ES_VERSION_3_2).
+func TexBuffer(target Enum, internalformat Enum, buffer uint32) {
+	syscall.Syscall(proc(procTexBuffer), 3, uintptr(target), uintptr(internalformat), uintptr(buffer))
+}
+
+// TexBufferRange calls glTexBufferRange (GL_ES_VERSION_3_2).
+func TexBufferRange(target Enum, internalformat Enum, buffer uint32, offset int, size int) {
+	syscall.Syscall6(proc(procTexBufferRange), 5, uintptr(target), uintptr(internalformat), uintptr(buffer), uintptr(offset), uintptr(size), 0)
+}
+
+// TexImage2D calls glTexImage2D (GL_ES_VERSION_2_0).
+func TexImage2D(target Enum, level int32, internalformat int32, width int32, height int32, border int32, format Enum, typ Enum, pixels unsafe.Pointer) {
+	syscall.Syscall9(proc(procTexImage2D), 9, uintptr(target), uintptr(level), uintptr(internalformat), uintptr(width), uintptr(height), uintptr(border), uintptr(format), uintptr(typ), uintptr(pixels))
+}
+
+// TexImage3D calls glTexImage3D (GL_ES_VERSION_3_0).
+func TexImage3D(target Enum, level int32, internalformat int32, width int32, height int32, depth int32, border int32, format Enum, typ Enum, pixels unsafe.Pointer) {
+	syscall.Syscall12(proc(procTexImage3D), 10, uintptr(target), uintptr(level), uintptr(internalformat), uintptr(width), uintptr(height), uintptr(depth), uintptr(border), uintptr(format), uintptr(typ), uintptr(pixels), 0, 0)
+}
+
+// TexParameterIiv calls glTexParameterIiv (GL_ES_VERSION_3_2).
+func TexParameterIiv(target Enum, pname Enum, params *int32) {
+	syscall.Syscall(proc(procTexParameterIiv), 3, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+}
+
+// TexParameterIuiv calls glTexParameterIuiv (GL_ES_VERSION_3_2).
+func TexParameterIuiv(target Enum, pname Enum, params *uint32) {
+	syscall.Syscall(proc(procTexParameterIuiv), 3, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+}
+
+// TexParameterf calls glTexParameterf (GL_ES_VERSION_2_0).
+//
+// It is not supported on windows/arm64: floating-point arguments are not passed by the syscall package.
+func TexParameterf(target Enum, pname Enum, param float32) {
+	panic("gl: glTexParameterf is not supported on windows/arm64")
+}
+
+// TexParameterfv calls glTexParameterfv (GL_ES_VERSION_2_0).
+func TexParameterfv(target Enum, pname Enum, params *float32) {
+	syscall.Syscall(proc(procTexParameterfv), 3, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+}
+
+// TexParameteri calls glTexParameteri (GL_ES_VERSION_2_0).
+func TexParameteri(target Enum, pname Enum, param int32) {
+	syscall.Syscall(proc(procTexParameteri), 3, uintptr(target), uintptr(pname), uintptr(param))
+}
+
+// TexParameteriv calls glTexParameteriv (GL_ES_VERSION_2_0).
+func TexParameteriv(target Enum, pname Enum, params *int32) {
+	syscall.Syscall(proc(procTexParameteriv), 3, uintptr(target), uintptr(pname), uintptr(unsafe.Pointer(params)))
+}
+
+// TexStorage2D calls glTexStorage2D (GL_ES_VERSION_3_0).
+func TexStorage2D(target Enum, levels int32, internalformat Enum, width int32, height int32) {
+	syscall.Syscall6(proc(procTexStorage2D), 5, uintptr(target), uintptr(levels), uintptr(internalformat), uintptr(width), uintptr(height), 0)
+}
+
+// TexStorage2DMultisample calls glTexStorage2DMultisample (GL_ES_VERSION_3_1).
+func TexStorage2DMultisample(target Enum, samples int32, internalformat Enum, width int32, height int32, fixedsamplelocations bool) {
+	syscall.Syscall6(proc(procTexStorage2DMultisample), 6, uintptr(target), uintptr(samples), uintptr(internalformat), uintptr(width), uintptr(height), boolArg(fixedsamplelocations))
+}
+
+// TexStorage3D calls glTexStorage3D (GL_ES_VERSION_3_0).
+func TexStorage3D(target Enum, levels int32, internalformat Enum, width int32, height int32, depth int32) {
+	syscall.Syscall6(proc(procTexStorage3D), 6, uintptr(target), uintptr(levels), uintptr(internalformat), uintptr(width), uintptr(height), uintptr(depth))
+}
+
+// TexStorage3DMultisample calls glTexStorage3DMultisample (GL_ES_VERSION_3_2).
+func TexStorage3DMultisample(target Enum, samples int32, internalformat Enum, width int32, height int32, depth int32, fixedsamplelocations bool) {
+	syscall.Syscall9(proc(procTexStorage3DMultisample), 7, uintptr(target), uintptr(samples), uintptr(internalformat), uintptr(width), uintptr(height), uintptr(depth), boolArg(fixedsamplelocations), 0, 0)
+}
+
+// TexSubImage2D calls glTexSubImage2D (GL_ES_VERSION_2_0).
+func TexSubImage2D(target Enum, level int32, xoffset int32, yoffset int32, width int32, height int32, format Enum, typ Enum, pixels unsafe.Pointer) {
+	syscall.Syscall9(proc(procTexSubImage2D), 9, uintptr(target), uintptr(level), uintptr(xoffset), uintptr(yoffset), uintptr(width), uintptr(height), uintptr(format), uintptr(typ), uintptr(pixels))
+}
+
+// TexSubImage3D calls glTexSubImage3D (GL_ES_VERSION_3_0).
+func TexSubImage3D(target Enum, level int32, xoffset int32, yoffset int32, zoffset int32, width int32, height int32, depth int32, format Enum, typ Enum, pixels unsafe.Pointer) {
+	syscall.Syscall12(proc(procTexSubImage3D), 11, uintptr(target), uintptr(level), uintptr(xoffset), uintptr(yoffset), uintptr(zoffset), uintptr(width), uintptr(height), uintptr(depth), uintptr(format), uintptr(typ), uintptr(pixels), 0)
+}
+
+// TransformFeedbackVaryings calls glTransformFeedbackVaryings (GL_ES_VERSION_3_0).
+func TransformFeedbackVaryings(program uint32, count int32, varyings **uint8, bufferMode Enum) {
+	syscall.Syscall6(proc(procTransformFeedbackVaryings), 4, uintptr(program), uintptr(count), uintptr(unsafe.Pointer(varyings)), uintptr(bufferMode), 0, 0)
+}
+
+// Uniform1f calls glUniform1f (GL_ES_VERSION_2_0).
+//
+// It is not supported on windows/arm64: floating-point arguments are not passed by the syscall package.
+func Uniform1f(location int32, v0 float32) {
+	panic("gl: glUniform1f is not supported on windows/arm64")
+}
+
+// Uniform1fv calls glUniform1fv (GL_ES_VERSION_2_0).
+func Uniform1fv(location int32, count int32, value *float32) {
+	syscall.Syscall(proc(procUniform1fv), 3, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+}
+
+// Uniform1i calls glUniform1i (GL_ES_VERSION_2_0).
+func Uniform1i(location int32, v0 int32) {
+	syscall.Syscall(proc(procUniform1i), 2, uintptr(location), uintptr(v0), 0)
+}
+
+// Uniform1iv calls glUniform1iv (GL_ES_VERSION_2_0).
+func Uniform1iv(location int32, count int32, value *int32) {
+	syscall.Syscall(proc(procUniform1iv), 3, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+}
+
+// Uniform1ui calls glUniform1ui (GL_ES_VERSION_3_0).
+func Uniform1ui(location int32, v0 uint32) {
+	syscall.Syscall(proc(procUniform1ui), 2, uintptr(location), uintptr(v0), 0)
+}
+
+// Uniform1uiv calls glUniform1uiv (GL_ES_VERSION_3_0).
+func Uniform1uiv(location int32, count int32, value *uint32) {
+	syscall.Syscall(proc(procUniform1uiv), 3, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+}
+
+// Uniform2f calls glUniform2f (GL_ES_VERSION_2_0).
+//
+// It is not supported on windows/arm64: floating-point arguments are not passed by the syscall package.
+func Uniform2f(location int32, v0 float32, v1 float32) {
+	panic("gl: glUniform2f is not supported on windows/arm64")
+}
+
+// Uniform2fv calls glUniform2fv (GL_ES_VERSION_2_0).
+func Uniform2fv(location int32, count int32, value *float32) {
+	syscall.Syscall(proc(procUniform2fv), 3, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+}
+
+// Uniform2i calls glUniform2i (GL_ES_VERSION_2_0).
+func Uniform2i(location int32, v0 int32, v1 int32) {
+	syscall.Syscall(proc(procUniform2i), 3, uintptr(location), uintptr(v0), uintptr(v1))
+}
+
+// Uniform2iv calls glUniform2iv (GL_ES_VERSION_2_0).
+func Uniform2iv(location int32, count int32, value *int32) {
+	syscall.Syscall(proc(procUniform2iv), 3, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+}
+
+// Uniform2ui calls glUniform2ui (GL_ES_VERSION_3_0).
+func Uniform2ui(location int32, v0 uint32, v1 uint32) {
+	syscall.Syscall(proc(procUniform2ui), 3, uintptr(location), uintptr(v0), uintptr(v1))
+}
+
+// Uniform2uiv calls glUniform2uiv (GL_ES_VERSION_3_0).
+func Uniform2uiv(location int32, count int32, value *uint32) {
+	syscall.Syscall(proc(procUniform2uiv), 3, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+}
+
+// Uniform3f calls glUniform3f (GL_ES_VERSION_2_0).
+//
+// It is not supported on windows/arm64: floating-point arguments are not passed by the syscall package.
+func Uniform3f(location int32, v0 float32, v1 float32, v2 float32) {
+	panic("gl: glUniform3f is not supported on windows/arm64")
+}
+
+// Uniform3fv calls glUniform3fv (GL_ES_VERSION_2_0).
+func Uniform3fv(location int32, count int32, value *float32) {
+	syscall.Syscall(proc(procUniform3fv), 3, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+}
+
+// Uniform3i calls glUniform3i (GL_ES_VERSION_2_0).
+func Uniform3i(location int32, v0 int32, v1 int32, v2 int32) {
+	syscall.Syscall6(proc(procUniform3i), 4, uintptr(location), uintptr(v0), uintptr(v1), uintptr(v2), 0, 0)
+}
+
+// Uniform3iv calls glUniform3iv (GL_ES_VERSION_2_0).
+func Uniform3iv(location int32, count int32, value *int32) {
+	syscall.Syscall(proc(procUniform3iv), 3, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+}
+
+// Uniform3ui calls glUniform3ui (GL_ES_VERSION_3_0).
+func Uniform3ui(location int32, v0 uint32, v1 uint32, v2 uint32) {
+	syscall.Syscall6(proc(procUniform3ui), 4, uintptr(location), uintptr(v0), uintptr(v1), uintptr(v2), 0, 0)
+}
+
+// Uniform3uiv calls glUniform3uiv (GL_ES_VERSION_3_0).
+func Uniform3uiv(location int32, count int32, value *uint32) {
+	syscall.Syscall(proc(procUniform3uiv), 3, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+}
+
+// Uniform4f calls glUniform4f (GL_ES_VERSION_2_0).
+//
+// It is not supported on windows/arm64: floating-point arguments are not passed by the syscall package.
+func Uniform4f(location int32, v0 float32, v1 float32, v2 float32, v3 float32) {
+	panic("gl: glUniform4f is not supported on windows/arm64")
+}
+
+// Uniform4fv calls glUniform4fv (GL_ES_VERSION_2_0).
+func Uniform4fv(location int32, count int32, value *float32) {
+	syscall.Syscall(proc(procUniform4fv), 3, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+}
+
+// Uniform4i calls glUniform4i (GL_ES_VERSION_2_0).
+func Uniform4i(location int32, v0 int32, v1 int32, v2 int32, v3 int32) {
+	syscall.Syscall6(proc(procUniform4i), 5, uintptr(location), uintptr(v0), uintptr(v1), uintptr(v2), uintptr(v3), 0)
+}
+
+// Uniform4iv calls glUniform4iv (GL_ES_VERSION_2_0).
+func Uniform4iv(location int32, count int32, value *int32) {
+	syscall.Syscall(proc(procUniform4iv), 3, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+}
+
+// Uniform4ui calls glUniform4ui (GL_ES_VERSION_3_0).
+func Uniform4ui(location int32, v0 uint32, v1 uint32, v2 uint32, v3 uint32) {
+	syscall.Syscall6(proc(procUniform4ui), 5, uintptr(location), uintptr(v0), uintptr(v1), uintptr(v2), uintptr(v3), 0)
+}
+
+// Uniform4uiv calls glUniform4uiv (GL_ES_VERSION_3_0).
+func Uniform4uiv(location int32, count int32, value *uint32) {
+	syscall.Syscall(proc(procUniform4uiv), 3, uintptr(location), uintptr(count), uintptr(unsafe.Pointer(value)))
+}
+
+// UniformBlockBinding calls glUniformBlockBinding (GL_ES_VERSION_3_0).
+func UniformBlockBinding(program uint32, uniformBlockIndex uint32, uniformBlockBinding uint32) {
+	syscall.Syscall(proc(procUniformBlockBinding), 3, uintptr(program), uintptr(uniformBlockIndex), uintptr(uniformBlockBinding))
+}
+
+// UniformMatrix2fv calls glUniformMatrix2fv (GL_ES_VERSION_2_0).
+func UniformMatrix2fv(location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(proc(procUniformMatrix2fv), 4, uintptr(location), uintptr(count), boolArg(transpose), uintptr(unsafe.Pointer(value)), 0, 0)
+}
+
+// UniformMatrix2x3fv calls glUniformMatrix2x3fv (GL_ES_VERSION_3_0).
+func UniformMatrix2x3fv(location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(proc(procUniformMatrix2x3fv), 4, uintptr(location), uintptr(count), boolArg(transpose), uintptr(unsafe.Pointer(value)), 0, 0)
+}
+
+// UniformMatrix2x4fv calls glUniformMatrix2x4fv (GL_ES_VERSION_3_0).
+func UniformMatrix2x4fv(location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(proc(procUniformMatrix2x4fv), 4, uintptr(location), uintptr(count), boolArg(transpose), uintptr(unsafe.Pointer(value)), 0, 0)
+}
+
+// UniformMatrix3fv calls glUniformMatrix3fv (GL_ES_VERSION_2_0).
+func UniformMatrix3fv(location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(proc(procUniformMatrix3fv), 4, uintptr(location), uintptr(count), boolArg(transpose), uintptr(unsafe.Pointer(value)), 0, 0)
+}
+
+// UniformMatrix3x2fv calls glUniformMatrix3x2fv (GL_ES_VERSION_3_0).
+func UniformMatrix3x2fv(location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(proc(procUniformMatrix3x2fv), 4, uintptr(location), uintptr(count), boolArg(transpose), uintptr(unsafe.Pointer(value)), 0, 0)
+}
+
+// UniformMatrix3x4fv calls glUniformMatrix3x4fv (GL_ES_VERSION_3_0).
+func UniformMatrix3x4fv(location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(proc(procUniformMatrix3x4fv), 4, uintptr(location), uintptr(count), boolArg(transpose), uintptr(unsafe.Pointer(value)), 0, 0)
+}
+
+// UniformMatrix4fv calls glUniformMatrix4fv (GL_ES_VERSION_2_0).
+func UniformMatrix4fv(location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(proc(procUniformMatrix4fv), 4, uintptr(location), uintptr(count), boolArg(transpose), uintptr(unsafe.Pointer(value)), 0, 0)
+}
+
+// UniformMatrix4x2fv calls glUniformMatrix4x2fv (GL_ES_VERSION_3_0).
+func UniformMatrix4x2fv(location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(proc(procUniformMatrix4x2fv), 4, uintptr(location), uintptr(count), boolArg(transpose), uintptr(unsafe.Pointer(value)), 0, 0)
+}
+
+// UniformMatrix4x3fv calls glUniformMatrix4x3fv (GL_ES_VERSION_3_0).
+func UniformMatrix4x3fv(location int32, count int32, transpose bool, value *float32) {
+	syscall.Syscall6(proc(procUniformMatrix4x3fv), 4, uintptr(location), uintptr(count), boolArg(transpose), uintptr(unsafe.Pointer(value)), 0, 0)
+}
+
+// UnmapBuffer calls glUnmapBuffer (GL_ES_VERSION_3_0).
+func UnmapBuffer(target Enum) bool {
+	r1, _, _ := syscall.Syscall(proc(procUnmapBuffer), 1, uintptr(target), 0, 0)
+	return uint8(r1) != 0
+}
+
+// UseProgram calls glUseProgram (GL_ES_VERSION_2_0).
+func UseProgram(program uint32) {
+	syscall.Syscall(proc(procUseProgram), 1, uintptr(program), 0, 0)
+}
+
+// UseProgramStages calls glUseProgramStages (GL_ES_VERSION_3_1).
+func UseProgramStages(pipeline uint32, stages uint32, program uint32) {
+	syscall.Syscall(proc(procUseProgramStages), 3, uintptr(pipeline), uintptr(stages), uintptr(program))
+}
+
+// ValidateProgram calls glValidateProgram (GL_ES_VERSION_2_0).
+func ValidateProgram(program uint32) {
+	syscall.Syscall(proc(procValidateProgram), 1, uintptr(program), 0, 0)
+}
+
+// ValidateProgramPipeline calls glValidateProgramPipeline (GL_ES_VERSION_3_1).
+func ValidateProgramPipeline(pipeline uint32) {
+	syscall.Syscall(proc(procValidateProgramPipeline), 1, uintptr(pipeline), 0, 0)
+}
+
+// VertexAttrib1f calls glVertexAttrib1f (GL_ES_VERSION_2_0).
+//
+// It is not supported on windows/arm64: floating-point arguments are not passed by the syscall package.
+func VertexAttrib1f(index uint32, x float32) {
+	panic("gl: glVertexAttrib1f is not supported on windows/arm64")
+}
+
+// VertexAttrib1fv calls glVertexAttrib1fv (GL_ES_VERSION_2_0).
+func VertexAttrib1fv(index uint32, v *float32) {
+	syscall.Syscall(proc(procVertexAttrib1fv), 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+}
+
+// VertexAttrib2f calls glVertexAttrib2f (GL_ES_VERSION_2_0).
+//
+// It is not supported on windows/arm64: floating-point arguments are not passed by the syscall package.
+func VertexAttrib2f(index uint32, x float32, y float32) {
+	panic("gl: glVertexAttrib2f is not supported on windows/arm64")
+}
+
+// VertexAttrib2fv calls glVertexAttrib2fv (GL_ES_VERSION_2_0).
+func VertexAttrib2fv(index uint32, v *float32) {
+	syscall.Syscall(proc(procVertexAttrib2fv), 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+}
+
+// VertexAttrib3f calls glVertexAttrib3f (GL_ES_VERSION_2_0).
+//
+// It is not supported on windows/arm64: floating-point arguments are not passed by the syscall package.
+func VertexAttrib3f(index uint32, x float32, y float32, z float32) {
+	panic("gl: glVertexAttrib3f is not supported on windows/arm64")
+}
+
+// VertexAttrib3fv calls glVertexAttrib3fv (GL_ES_VERSION_2_0).
+func VertexAttrib3fv(index uint32, v *float32) {
+	syscall.Syscall(proc(procVertexAttrib3fv), 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+}
+
+// VertexAttrib4f calls glVertexAttrib4f (GL_ES_VERSION_2_0).
+//
+// It is not supported on windows/arm64: floating-point arguments are not passed by the syscall package.
+func VertexAttrib4f(index uint32, x float32, y float32, z float32, w float32) {
+	panic("gl: glVertexAttrib4f is not supported on windows/arm64")
+}
+
+// VertexAttrib4fv calls glVertexAttrib4fv (GL_ES_VERSION_2_0).
+func VertexAttrib4fv(index uint32, v *float32) {
+	syscall.Syscall(proc(procVertexAttrib4fv), 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+}
+
+// VertexAttribBinding calls glVertexAttribBinding (GL_ES_VERSION_3_1).
+func VertexAttribBinding(attribindex uint32, bindingindex uint32) {
+	syscall.Syscall(proc(procVertexAttribBinding), 2, uintptr(attribindex), uintptr(bindingindex), 0)
+}
+
+// VertexAttribDivisor calls glVertexAttribDivisor (GL_ES_VERSION_3_0).
+func VertexAttribDivisor(index uint32, divisor uint32) {
+	syscall.Syscall(proc(procVertexAttribDivisor), 2, uintptr(index), uintptr(divisor), 0)
+}
+
+// VertexAttribFormat calls glVertexAttribFormat (GL_ES_VERSION_3_1).
+func VertexAttribFormat(attribindex uint32, size int32, typ Enum, normalized bool, relativeoffset uint32) {
+	syscall.Syscall6(proc(procVertexAttribFormat), 5, uintptr(attribindex), uintptr(size), uintptr(typ), boolArg(normalized), uintptr(relativeoffset), 0)
+}
+
+// VertexAttribI4i calls glVertexAttribI4i (GL_ES_VERSION_3_0).
+func VertexAttribI4i(index uint32, x int32, y int32, z int32, w int32) {
+	syscall.Syscall6(proc(procVertexAttribI4i), 5, uintptr(index), uintptr(x), uintptr(y), uintptr(z), uintptr(w), 0)
+}
+
+// VertexAttribI4iv calls glVertexAttribI4iv (GL_ES_VERSION_3_0).
+func VertexAttribI4iv(index uint32, v *int32) {
+	syscall.Syscall(proc(procVertexAttribI4iv), 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+}
+
+// VertexAttribI4ui calls glVertexAttribI4ui (GL_ES_VERSION_3_0).
+func VertexAttribI4ui(index uint32, x uint32, y uint32, z uint32, w uint32) {
+	syscall.Syscall6(proc(procVertexAttribI4ui), 5, uintptr(index), uintptr(x), uintptr(y), uintptr(z), uintptr(w), 0)
+}
+
+// VertexAttribI4uiv calls glVertexAttribI4uiv (GL_ES_VERSION_3_0).
+func VertexAttribI4uiv(index uint32, v *uint32) {
+	syscall.Syscall(proc(procVertexAttribI4uiv), 2, uintptr(index), uintptr(unsafe.Pointer(v)), 0)
+}
+
+// VertexAttribIFormat calls glVertexAttribIFormat (GL_ES_VERSION_3_1).
+func VertexAttribIFormat(attribindex uint32, size int32, typ Enum, relativeoffset uint32) {
+	syscall.Syscall6(proc(procVertexAttribIFormat), 4, uintptr(attribindex), uintptr(size), uintptr(typ), uintptr(relativeoffset), 0, 0)
+}
+
+// VertexAttribIPointer calls glVertexAttribIPointer (GL_ES_VERSION_3_0).
+func VertexAttribIPointer(index uint32, size int32, typ Enum, stride int32, pointer unsafe.Pointer) {
+	syscall.Syscall6(proc(procVertexAttribIPointer), 5, uintptr(index), uintptr(size), uintptr(typ), uintptr(stride), uintptr(pointer), 0)
+}
+
+// VertexAttribPointer calls glVertexAttribPointer (GL_ES_VERSION_2_0).
+func VertexAttribPointer(index uint32, size int32, typ Enum, normalized bool, stride int32, pointer unsafe.Pointer) {
+	syscall.Syscall6(proc(procVertexAttribPointer), 6, uintptr(index), uintptr(size), uintptr(typ), boolArg(normalized), uintptr(stride), uintptr(pointer))
+}
+
+// VertexBindingDivisor calls glVertexBindingDivisor (GL_ES_VERSION_3_1).
+func VertexBindingDivisor(bindingindex uint32, divisor uint32) {
+	syscall.Syscall(proc(procVertexBindingDivisor), 2, uintptr(bindingindex), uintptr(divisor), 0)
+}
+
+// Viewport calls glViewport (GL_ES_VERSION_2_0).
+func Viewport(x int32, y int32, width int32, height int32) {
+	syscall.Syscall6(proc(procViewport), 4, uintptr(x), uintptr(y), uintptr(width), uintptr(height), 0, 0)
+}
+
+// WaitSync calls glWaitSync (GL_ES_VERSION_3_0).
+func WaitSync(sync uintptr, flags uint32, timeout uint64) {
+	syscall.Syscall(proc(procWaitSync), 3, uintptr(sync), uintptr(flags), uintptr(timeout))
+}
@@ -0,0 +1,901 @@
+//go:build ignore
+// +build ignore
+
+// This program generates the OpenGL binding from the Khronos registry
+// (gl.xml). It can be invoked by running go generate; the flags select the API,
+// version and extensions included in the binding:
+//
+//	-api gles2 -version 3.2 -ext GL_KHR_debug
+//	-api gl -profile core -version 3.3
+//
+// It writes enum.go (the constants), procs.go (the table of proc names used by
+// the lazy loader) and the wrappers for the cgo and Windows paths: funcs_cgo.go,
+// and one file per Windows architecture (funcs_windows_amd64.go,
+// funcs_windows_386.go and funcs_windows_arm64.go), since the syscall package
+// passes arguments and returns results differently on each of them.
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	registry = flag.String("registry", "gl.xml", "path of the Khronos registry")
+	api      = flag.String("api", "gles2", "API of the binding (gl or gles2)")
+	version  = flag.String("version", "3.2", "highest version included in the binding")
+	profile  = flag.String("profile", "core", "profile of the binding (core or compatibility, gl only)")
+	exts     = flag.String("ext", "", "comma-separated list of the extensions included in the binding")
+	trim     = flag.String("trim", "KHR", "comma-separated list of extension suffixes removed from the Go names, when the registry defines an equivalent unsuffixed name")
+	skip     = flag.String("skip", "", "comma-separated list of commands not to generate")
+)
+
+////////////////////////////////////////////////////////////////////////////////
+
+// The registry schema (only the parts used by the generator).
+
+type xmlRegistry struct {
+	Enums      []xmlEnums   `xml:"enums"`
+	Commands   []xmlCommand `xml:"commands>command"`
+	Features   []xmlFeature `xml:"feature"`
+	Extensions []xmlFeature `xml:"extensions>extension"`
+}
+
+type xmlEnums struct {
+	Type  string    `xml:"type,attr"`
+	Enums []xmlEnum `xml:"enum"`
+}
+
+type xmlEnum struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+	API   string `xml:"api,attr"`
+}
+
+type xmlCommand struct {
+	Proto  xmlInner   `xml:"proto"`
+	Params []xmlInner `xml:"param"`
+	Alias  xmlName    `xml:"alias"`
+}
+
+type xmlInner struct {
+	Inner string `xml:",innerxml"`
+}
+
+type xmlName struct {
+	Name string `xml:"name,attr"`
+}
+
+type xmlFeature struct {
+	API       string       `xml:"api,attr"`
+	Name      string       `xml:"name,attr"`
+	Number    string       `xml:"number,attr"`
+	Supported string       `xml:"supported,attr"`
+	Require   []xmlRequire `xml:"require"`
+	Remove    []xmlRequire `xml:"remove"`
+}
+
+type xmlRequire struct {
+	API      string    `xml:"api,attr"`
+	Profile  string    `xml:"profile,attr"`
+	Enums    []xmlName `xml:"enum"`
+	Commands []xmlName `xml:"command"`
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// The C types of the registry, with their definition in the cgo preamble and
+// their Go equivalent. The types whose Go equivalent is uintptr are handles,
+// passed by value; "struct" marks the opaque structures, only used through
+// pointers.
+var types = map[string]struct{ c, golang string }{
+	"GLenum":               {"unsigned int", "Enum"},
+	"GLboolean":            {"unsigned char", "bool"},
+	"GLbitfield":           {"unsigned int", "uint32"},
+	"GLbyte":               {"signed char", "int8"},
+	"GLubyte":              {"unsigned char", "uint8"},
+	"GLshort":              {"short", "int16"},
+	"GLushort":             {"unsigned short", "uint16"},
+	"GLint":                {"int", "int32"},
+	"GLuint":               {"unsigned int", "uint32"},
+	"GLclampx":             {"int", "int32"},
+	"GLsizei":              {"int", "int32"},
+	"GLfixed":              {"int", "int32"},
+	"GLhalf":               {"unsigned short", "uint16"},
+	"GLhalfNV":             {"unsigned short", "uint16"},
+	"GLfloat":              {"float", "float32"},
+	"GLclampf":             {"float", "float32"},
+	"GLdouble":             {"double", "float64"},
+	"GLclampd":             {"double", "float64"},
+	"GLchar":               {"char", "uint8"},
+	"GLcharARB":            {"char", "uint8"},
+	"GLhandleARB":          {"unsigned int", "uint32"},
+	"GLintptr":             {"intptr_t", "int"},
+	"GLintptrARB":          {"intptr_t", "int"},
+	"GLsizeiptr":           {"intptr_t", "int"},
+	"GLsizeiptrARB":        {"intptr_t", "int"},
+	"GLvdpauSurfaceNV":     {"intptr_t", "int"},
+	"GLint64":              {"int64_t", "int64"},
+	"GLint64EXT":           {"int64_t", "int64"},
+	"GLuint64":             {"uint64_t", "uint64"},
+	"GLuint64EXT":          {"uint64_t", "uint64"},
+	"GLsync":               {"struct __GLsync *", "uintptr"},
+	"GLeglImageOES":        {"void *", "unsafe.Pointer"},
+	"GLeglClientBufferEXT": {"void *", "unsafe.Pointer"},
+	"GLDEBUGPROC":          {"void *", "uintptr"},
+	"GLDEBUGPROCARB":       {"void *", "uintptr"},
+	"GLDEBUGPROCKHR":       {"void *", "uintptr"},
+	"GLDEBUGPROCAMD":       {"void *", "uintptr"},
+	"GLVULKANPROCNV":       {"void *", "uintptr"},
+	"_cl_context":          {"struct", "unsafe.Pointer"},
+	"_cl_event":            {"struct", "unsafe.Pointer"},
+}
+
+// Identifiers that cannot be used as parameter names in the wrappers: the Go
+// keywords, and the names used in the body of the wrappers.
+var reserved = map[string]string{
+	"break":       "brk",
+	"case":        "cas",
+	"chan":        "ch",
+	"const":       "cnst",
+	"continue":    "cont",
+	"default":     "def",
+	"defer":       "dfr",
+	"else":        "els",
+	"fallthrough": "fallthru",
+	"for":         "fr",
+	"func":        "fn",
+	"go":          "g",
+	"goto":        "gto",
+	"if":          "iff",
+	"import":      "imp",
+	"interface":   "iface",
+	"map":         "mapping",
+	"package":     "pkg",
+	"range":       "rng",
+	"return":      "ret",
+	"select":      "sel",
+	"struct":      "strct",
+	"switch":      "swtch",
+	"type":        "typ",
+	"var":         "vr",
+	"string":      "str",
+	"len":         "length",
+	"proc":        "prc",
+	"unsafe":      "unsf",
+	"math":        "mth",
+	"syscall":     "sysc",
+	"r1":          "res1",
+	"r2":          "res2",
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+type param struct {
+	name  string // Go name
+	ptype string // registry type, or "" for void
+	ctype string // full C type
+	ptr   int    // level of indirection
+	cnst  bool
+}
+
+type command struct {
+	goName  string
+	names   []string // registry names, in the order they are tried
+	feature string   // first feature or extension requiring the command
+	result  param
+	params  []param
+}
+
+type enum struct {
+	goName  string
+	value   string
+	feature string
+	bitmask bool
+}
+
+var (
+	nameRe  = regexp.MustCompile(`<name>\s*(\w+)\s*</name>`)
+	ptypeRe = regexp.MustCompile(`<ptype>\s*(\w+)\s*</ptype>`)
+	tagRe   = regexp.MustCompile(`<[^>]*>`)
+	arrayRe = regexp.MustCompile(`\[\s*\w*\s*\]`)
+
+	goBuildRe = regexp.MustCompile(`(?m)^//go:build .*\n`)
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("gen: ")
+	flag.Parse()
+
+	src, err := ioutil.ReadFile(*registry)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var r xmlRegistry
+	err = xml.Unmarshal(src, &r)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	enums, commands := selection(&r)
+
+	write("enum.go", genEnums(enums))
+	write("procs.go", genProcs(commands))
+	write("funcs_cgo.go", genCgo(commands))
+	for _, a := range windowsArchs {
+		write("funcs_windows_"+a.name+".go", genWindows(commands, a))
+	}
+}
+
+func write(path string, b []byte) {
+	src, err := format.Source(b)
+	if err != nil {
+		log.Fatalf("%s: %v", path, err)
+	}
+	// Keep the build constraints in the style of the rest of the package
+	src = goBuildRe.ReplaceAll(src, nil)
+	err = ioutil.WriteFile(path, src, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func split(s string) map[string]bool {
+	m := map[string]bool{}
+	for _, x := range strings.Split(s, ",") {
+		x = strings.TrimSpace(x)
+		if x != "" {
+			m[x] = true
+		}
+	}
+	return m
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// selection returns the enums and commands required by the features and
+// extensions selected by the flags.
+func selection(r *xmlRegistry) ([]enum, []command) {
+	required := map[string]string{} // enum or command name -> feature name
+	listed := map[string]bool{}
+	var order []string
+
+	add := func(req xmlRequire, feature string) {
+		for _, n := range append(req.Enums, req.Commands...) {
+			if !listed[n.Name] {
+				listed[n.Name] = true
+				order = append(order, n.Name)
+			}
+			if _, ok := required[n.Name]; !ok {
+				required[n.Name] = feature
+			}
+		}
+	}
+	remove := func(req xmlRequire) {
+		for _, n := range append(req.Enums, req.Commands...) {
+			delete(required, n.Name)
+		}
+	}
+	matches := func(req xmlRequire) bool {
+		if req.API != "" && req.API != *api {
+			return false
+		}
+		return req.Profile == "" || *api != "gl" || req.Profile == *profile
+	}
+
+	for _, f := range r.Features {
+		if f.API != *api || !atMost(f.Number, *version) {
+			continue
+		}
+		for _, req := range f.Require {
+			if matches(req) {
+				add(req, f.Name)
+			}
+		}
+		for _, req := range f.Remove {
+			if matches(req) {
+				remove(req)
+			}
+		}
+	}
+
+	selected := split(*exts)
+	for _, e := range r.Extensions {
+		if !selected[e.Name] {
+			continue
+		}
+		delete(selected, e.Name)
+		if !supports(e.Supported) {
+			log.Printf("extension %s not supported by API %s", e.Name, *api)
+			continue
+		}
+		for _, req := range e.Require {
+			if matches(req) {
+				add(req, e.Name)
+			}
+		}
+	}
+	for n := range selected {
+		log.Printf("extension %s not found", n)
+	}
+
+	// Enums
+
+	values := map[string]string{}
+	bitmasks := map[string]bool{}
+	for _, g := range r.Enums {
+		for _, e := range g.Enums {
+			if e.API == "" || e.API == *api {
+				values[e.Name] = e.Value
+				bitmasks[e.Name] = g.Type == "bitmask"
+			}
+		}
+	}
+
+	var enums []enum
+	seen := map[string]bool{}
+	for _, n := range order {
+		f, ok := required[n]
+		if !ok || !strings.HasPrefix(n, "GL_") {
+			continue
+		}
+		v, ok := values[n]
+		if !ok {
+			continue
+		}
+		base := n
+		for s := range split(*trim) {
+			b := strings.TrimSuffix(n, "_"+s)
+			if b != n && values[b] == v {
+				base = b
+			}
+		}
+		g := goEnumName(base)
+		if seen[g] {
+			continue
+		}
+		seen[g] = true
+		enums = append(enums, enum{goName: g, value: v, feature: f, bitmask: bitmasks[n]})
+	}
+	sort.Slice(enums, func(i, j int) bool { return enums[i].goName < enums[j].goName })
+
+	// Commands
+
+	defs := map[string]*xmlCommand{}
+	for i := range r.Commands {
+		c := &r.Commands[i]
+		defs[nameRe.FindStringSubmatch(c.Proto.Inner)[1]] = c
+	}
+
+	skipped := split(*skip)
+	byName := map[string]*command{}
+	var commands []*command
+	for _, n := range order {
+		f, ok := required[n]
+		if !ok || !strings.HasPrefix(n, "gl") {
+			continue
+		}
+		d := defs[n]
+		if d == nil {
+			log.Printf("command %s not found", n)
+			continue
+		}
+		base := n
+		if a := d.Alias.Name; a != "" {
+			for s := range split(*trim) {
+				if strings.TrimSuffix(n, s) == a {
+					base = a
+				}
+			}
+		}
+		if skipped[n] || skipped[base] {
+			continue
+		}
+		g := strings.TrimPrefix(base, "gl")
+		if c, ok := byName[g]; ok {
+			// The unsuffixed name is tried first
+			if n == base {
+				c.names = append([]string{n}, c.names...)
+			} else {
+				c.names = append(c.names, n)
+			}
+			continue
+		}
+		c, err := parseCommand(d)
+		if err != nil {
+			log.Printf("command %s skipped: %v", n, err)
+			continue
+		}
+		c.goName = g
+		c.names = []string{n}
+		c.feature = f
+		byName[g] = c
+		commands = append(commands, c)
+	}
+	sort.Slice(commands, func(i, j int) bool { return commands[i].goName < commands[j].goName })
+
+	cc := make([]command, len(commands))
+	for i := range commands {
+		cc[i] = *commands[i]
+	}
+	return enums, cc
+}
+
+// atMost returns true if the version number a is lower or equal to b.
+func atMost(a, b string) bool {
+	pa, pb := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(pa) && i < len(pb); i++ {
+		x, _ := strconv.Atoi(pa[i])
+		y, _ := strconv.Atoi(pb[i])
+		if x != y {
+			return x < y
+		}
+	}
+	return len(pa) <= len(pb)
+}
+
+func supports(s string) bool {
+	for _, a := range strings.Split(s, "|") {
+		if a == *api || (*api == "gl" && *profile == "core" && a == "glcore") {
+			return true
+		}
+	}
+	return false
+}
+
+func goEnumName(n string) string {
+	g := strings.TrimPrefix(n, "GL_")
+	if g == "" || (g[0] >= '0' && g[0] <= '9') {
+		return n
+	}
+	return g
+}
+
+func parseCommand(d *xmlCommand) (*command, error) {
+	var c command
+	var err error
+	c.result, err = parseParam(d.Proto.Inner)
+	if err != nil {
+		return nil, err
+	}
+	for i, p := range d.Params {
+		pp, err := parseParam(p.Inner)
+		if err != nil {
+			return nil, err
+		}
+		if pp.ptype == "" && pp.ptr == 0 {
+			return nil, fmt.Errorf("void parameter")
+		}
+		if r, ok := reserved[pp.name]; ok {
+			pp.name = r
+		}
+		if pp.name == "" {
+			pp.name = fmt.Sprintf("p%d", i)
+		}
+		c.params = append(c.params, pp)
+	}
+	return &c, nil
+}
+
+func parseParam(s string) (param, error) {
+	var p param
+	if m := nameRe.FindStringSubmatch(s); m != nil {
+		p.name = m[1]
+	}
+	if m := ptypeRe.FindStringSubmatch(s); m != nil {
+		p.ptype = m[1]
+		if _, ok := types[p.ptype]; !ok {
+			return p, fmt.Errorf("unsupported type %s", p.ptype)
+		}
+	}
+	s = nameRe.ReplaceAllString(s, "")
+	s = tagRe.ReplaceAllString(s, "")
+	if arrayRe.MatchString(p.name + s) {
+		s = arrayRe.ReplaceAllString(s, "") + "*"
+	}
+	s = strings.Join(strings.Fields(s), " ")
+	s = strings.Replace(s, " *", "*", -1)
+	s = strings.Replace(s, "*", " *", 1)
+	p.ctype = s
+	p.ptr = strings.Count(s, "*")
+	p.cnst = strings.HasPrefix(s, "const ")
+	return p, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// goType returns the Go type of a parameter or result.
+func (p param) goType() string {
+	switch {
+	case p.ptr == 0 && p.ptype == "":
+		return ""
+	case p.ptr == 0:
+		return types[p.ptype].golang
+	case p.ptype == "" || types[p.ptype].c == "struct":
+		return strings.Repeat("*", p.ptr-1) + "unsafe.Pointer"
+	case p.ptr == 1 && p.cnst && (p.ptype == "GLchar" || p.ptype == "GLcharARB"):
+		return "string"
+	}
+	return strings.Repeat("*", p.ptr) + types[p.ptype].golang
+}
+
+// isHandle returns true for the handles (GLsync, the callbacks), passed as
+// uintptr.
+func (p param) isHandle() bool {
+	return p.ptr == 0 && p.ptype != "" && types[p.ptype].golang == "uintptr"
+}
+
+// isString returns true for the results of glGetString and similar.
+func (p param) isString() bool {
+	return p.ptr == 1 && (p.ptype == "GLubyte" || p.ptype == "GLchar")
+}
+
+func (c *command) signature() string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "%s(", c.goName)
+	for i, p := range c.params {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "%s %s", p.name, p.goType())
+	}
+	b.WriteString(")")
+	if c.result.ptr > 0 && c.result.isString() {
+		b.WriteString(" string")
+	} else if t := c.result.goType(); t != "" {
+		b.WriteString(" " + t)
+	}
+	return b.String()
+}
+
+func (c *command) doc(b *bytes.Buffer) {
+	fmt.Fprintf(b, "// %s calls %s (%s).\n", c.goName, strings.Join(c.names, " or "), c.feature)
+}
+
+func header(b *bytes.Buffer) {
+	e := *exts
+	if e == "" {
+		e = "none"
+	}
+	fmt.Fprintf(b, "// Code generated by \"go run gen.go\"; DO NOT EDIT.\n")
+	fmt.Fprintf(b, "// API: %s %s", *api, *version)
+	if *api == "gl" {
+		fmt.Fprintf(b, " %s", *profile)
+	}
+	fmt.Fprintf(b, ", extensions: %s.\n\n", e)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+func genEnums(enums []enum) []byte {
+	var b bytes.Buffer
+	header(&b)
+	fmt.Fprintf(&b, "package gl\n\n")
+	fmt.Fprintf(&b, "// An Enum is an OpenGL enumerated value (GLenum).\ntype Enum uint32\n\n")
+	fmt.Fprintf(&b, "const (\n")
+	for _, e := range enums {
+		// Bitmasks are untyped, since the functions take them as GLbitfield
+		v, err := strconv.ParseUint(e.value, 0, 32)
+		if err == nil && !e.bitmask {
+			fmt.Fprintf(&b, "\t%s Enum = 0x%04X // %s\n", e.goName, v, e.feature)
+		} else {
+			fmt.Fprintf(&b, "\t%s = %s // %s\n", e.goName, e.value, e.feature)
+		}
+	}
+	fmt.Fprintf(&b, ")\n")
+	return b.Bytes()
+}
+
+func genProcs(commands []command) []byte {
+	var b bytes.Buffer
+	header(&b)
+	fmt.Fprintf(&b, "package gl\n\n")
+	fmt.Fprintf(&b, "// Indices in the proc address table.\nconst (\n")
+	for i, c := range commands {
+		if i == 0 {
+			fmt.Fprintf(&b, "\tproc%s = iota\n", c.goName)
+		} else {
+			fmt.Fprintf(&b, "\tproc%s\n", c.goName)
+		}
+	}
+	fmt.Fprintf(&b, "\tnumProcs\n)\n\n")
+	fmt.Fprintf(&b, "// procNames lists, for each entry of the proc address table, the names under\n")
+	fmt.Fprintf(&b, "// which the function is looked up.\n")
+	fmt.Fprintf(&b, "var procNames = [numProcs][]string{\n")
+	for _, c := range commands {
+		fmt.Fprintf(&b, "\tproc%s: {%q", c.goName, c.names[0])
+		for _, n := range c.names[1:] {
+			fmt.Fprintf(&b, ", %q", n)
+		}
+		fmt.Fprintf(&b, "},\n")
+	}
+	fmt.Fprintf(&b, "}\n")
+	return b.Bytes()
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// cParam returns the type of a parameter in the C trampolines: pointers are
+// passed as void *, and handles as uintptr_t.
+func (p param) cParam() string {
+	switch {
+	case p.ptr > 0 || p.goType() == "unsafe.Pointer":
+		return "void *"
+	case p.isHandle():
+		return "uintptr_t"
+	}
+	return p.ptype
+}
+
+func (p param) cResult() string {
+	switch {
+	case p.ptr == 0 && p.ptype == "":
+		return "void"
+	case p.isString():
+		return "const char *"
+	case p.ptr > 0 || p.goType() == "unsafe.Pointer":
+		return "void *"
+	case p.isHandle():
+		return "uintptr_t"
+	}
+	return p.ptype
+}
+
+func genCgo(commands []command) []byte {
+	var b bytes.Buffer
+	header(&b)
+	fmt.Fprintf(&b, "// +build !windows\n\npackage gl\n\n")
+
+	fmt.Fprintf(&b, "/*\n#include <stdint.h>\n#include <stdlib.h>\n\n")
+	var names []string
+	for n := range types {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		if types[n].c == "struct" {
+			fmt.Fprintf(&b, "struct %s;\n", n)
+		} else {
+			fmt.Fprintf(&b, "typedef %s %s;\n", types[n].c, n)
+		}
+	}
+	b.WriteString("\n")
+	for _, c := range commands {
+		var proto, args, call []string
+		args = append(args, "uintptr_t fn")
+		for i, p := range c.params {
+			proto = append(proto, p.ctype)
+			args = append(args, fmt.Sprintf("%s p%d", p.cParam(), i))
+			if p.isHandle() {
+				call = append(call, fmt.Sprintf("(%s)p%d", p.ptype, i))
+			} else {
+				call = append(call, fmt.Sprintf("p%d", i))
+			}
+		}
+		if len(proto) == 0 {
+			proto = []string{"void"}
+		}
+		r := c.result.cResult()
+		ret := "return "
+		if r == "void" {
+			ret = ""
+		} else if r != c.result.ctype {
+			ret += "(" + r + ")"
+		}
+		fmt.Fprintf(&b, "static %s call%s(%s) {\n", r, c.goName, strings.Join(args, ", "))
+		fmt.Fprintf(&b, "\t%s((%s (*)(%s))fn)(%s);\n}\n", ret, c.result.ctype, strings.Join(proto, ", "), strings.Join(call, ", "))
+	}
+	fmt.Fprintf(&b, "*/\nimport \"C\"\n\n")
+
+	var f bytes.Buffer
+	for _, c := range commands {
+		c.doc(&f)
+		fmt.Fprintf(&f, "func %s {\n", c.signature())
+		args := []string{fmt.Sprintf("C.uintptr_t(proc(proc%s))", c.goName)}
+		for i, p := range c.params {
+			switch t := p.goType(); {
+			case t == "string":
+				fmt.Fprintf(&f, "\tcs%d := C.CString(%s)\n", i, p.name)
+				fmt.Fprintf(&f, "\tdefer C.free(unsafe.Pointer(cs%d))\n", i)
+				args = append(args, fmt.Sprintf("unsafe.Pointer(cs%d)", i))
+			case t == "unsafe.Pointer":
+				args = append(args, p.name)
+			case p.ptr > 0:
+				args = append(args, fmt.Sprintf("unsafe.Pointer(%s)", p.name))
+			case t == "bool":
+				args = append(args, fmt.Sprintf("cbool(%s)", p.name))
+			case p.isHandle():
+				args = append(args, fmt.Sprintf("C.uintptr_t(%s)", p.name))
+			default:
+				args = append(args, fmt.Sprintf("C.%s(%s)", p.ptype, p.name))
+			}
+		}
+		call := fmt.Sprintf("C.call%s(%s)", c.goName, strings.Join(args, ", "))
+		switch t := c.result.goType(); {
+		case t == "":
+			fmt.Fprintf(&f, "\t%s\n", call)
+		case c.result.isString():
+			fmt.Fprintf(&f, "\treturn C.GoString(%s)\n", call)
+		case t == "unsafe.Pointer":
+			fmt.Fprintf(&f, "\treturn %s\n", call)
+		case c.result.ptr > 0:
+			fmt.Fprintf(&f, "\treturn (%s)(%s)\n", t, call)
+		case t == "bool":
+			fmt.Fprintf(&f, "\treturn %s != 0\n", call)
+		default:
+			fmt.Fprintf(&f, "\treturn %s(%s)\n", t, call)
+		}
+		fmt.Fprintf(&f, "}\n\n")
+	}
+	if bytes.Contains(f.Bytes(), []byte("unsafe.")) {
+		fmt.Fprintf(&b, "import \"unsafe\"\n\n")
+	}
+	if bytes.Contains(f.Bytes(), []byte("cbool(")) {
+		fmt.Fprintf(&b, "func cbool(b bool) C.GLboolean {\n\tif b {\n\t\treturn 1\n\t}\n\treturn 0\n}\n\n")
+	}
+	b.Write(f.Bytes())
+	return b.Bytes()
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// A windowsArch describes how the syscall package passes arguments and returns
+// results on a Windows architecture.
+type windowsArch struct {
+	name       string
+	wordSize   int  // size of the syscall arguments, in bits
+	floatArgs  bool // floating-point arguments can be passed as integer words
+	floatRes   bool // floating-point results are returned in r2
+	maxSyscall int  // largest number of arguments of the Syscall functions
+}
+
+var windowsArchs = []windowsArch{
+	// The syscall package copies the first four arguments to the XMM
+	// registers, and XMM0 to r2.
+	{name: "amd64", wordSize: 64, floatArgs: true, floatRes: true, maxSyscall: 18},
+	// Floating-point results are returned on the x87 stack, which is lost.
+	{name: "386", wordSize: 32, floatArgs: true, floatRes: false, maxSyscall: 18},
+	// Floating-point arguments and results use the SIMD registers, which the
+	// syscall package does not set.
+	{name: "arm64", wordSize: 64, floatArgs: false, floatRes: false, maxSyscall: 18},
+}
+
+func genWindows(commands []command, arch windowsArch) []byte {
+	var b bytes.Buffer
+	header(&b)
+	fmt.Fprintf(&b, "package gl\n\n")
+
+	var f bytes.Buffer
+	skipped := 0
+	for _, c := range commands {
+		args, unsupported := arch.args(&c)
+		n := len(args)
+		fn := "Syscall"
+		switch {
+		case unsupported != "":
+		case n <= 3:
+		case n <= 6:
+			fn = "Syscall6"
+		case n <= 9:
+			fn = "Syscall9"
+		case n <= 12:
+			fn = "Syscall12"
+		case n <= 15:
+			fn = "Syscall15"
+		case n <= arch.maxSyscall:
+			fn = "Syscall18"
+		default:
+			unsupported = fmt.Sprintf("it has more than %d arguments", arch.maxSyscall)
+		}
+
+		c.doc(&f)
+		if unsupported != "" {
+			skipped++
+			fmt.Fprintf(&f, "//\n// It is not supported on windows/%s: %s.\n", arch.name, unsupported)
+			fmt.Fprintf(&f, "func %s {\n", c.signature())
+			fmt.Fprintf(&f, "\tpanic(\"gl: %s is not supported on windows/%s\")\n}\n\n", c.names[0], arch.name)
+			continue
+		}
+		for len(args) < 3 || (len(args)-3)%3 != 0 {
+			args = append(args, "0")
+		}
+
+		fmt.Fprintf(&f, "func %s {\n", c.signature())
+		call := fmt.Sprintf("syscall.%s(proc(proc%s), %d, %s)", fn, c.goName, n, strings.Join(args, ", "))
+		t := c.result.goType()
+		switch {
+		case t == "":
+			fmt.Fprintf(&f, "\t%s\n}\n\n", call)
+			continue
+		case t == "float32" || t == "float64":
+			fmt.Fprintf(&f, "\t_, r2, _ := %s\n", call)
+		case arch.wordSize == 32 && (t == "int64" || t == "uint64"):
+			fmt.Fprintf(&f, "\tr1, r2, _ := %s\n", call)
+		default:
+			fmt.Fprintf(&f, "\tr1, _, _ := %s\n", call)
+		}
+		switch {
+		case c.result.isString():
+			fmt.Fprintf(&f, "\treturn goString(r1)\n")
+		case t == "unsafe.Pointer" || c.result.ptr > 0:
+			fmt.Fprintf(&f, "\treturn *(*%s)(unsafe.Pointer(&r1))\n", t)
+		case t == "bool":
+			fmt.Fprintf(&f, "\treturn uint8(r1) != 0\n")
+		case t == "float32":
+			fmt.Fprintf(&f, "\treturn math.Float32frombits(uint32(r2))\n")
+		case t == "float64":
+			fmt.Fprintf(&f, "\treturn math.Float64frombits(uint64(r2))\n")
+		case arch.wordSize == 32 && t == "uint64":
+			fmt.Fprintf(&f, "\treturn uint64(r1) | uint64(r2)<<32\n")
+		case arch.wordSize == 32 && t == "int64":
+			fmt.Fprintf(&f, "\treturn int64(uint64(r1) | uint64(r2)<<32)\n")
+		default:
+			fmt.Fprintf(&f, "\treturn %s(r1)\n", t)
+		}
+		fmt.Fprintf(&f, "}\n\n")
+	}
+	if skipped > 0 {
+		log.Printf("%d commands not supported on windows/%s", skipped, arch.name)
+	}
+
+	fmt.Fprintf(&b, "import (\n")
+	for _, p := range []string{"math", "syscall", "unsafe"} {
+		if bytes.Contains(f.Bytes(), []byte(p+".")) {
+			fmt.Fprintf(&b, "\t%q\n", p)
+		}
+	}
+	fmt.Fprintf(&b, ")\n\n")
+	b.Write(f.Bytes())
+	return b.Bytes()
+}
+
+// args returns the syscall arguments of a command, or the reason why it cannot
+// be called on the architecture.
+func (arch windowsArch) args(c *command) ([]string, string) {
+	if t := c.result.goType(); !arch.floatRes && (t == "float32" || t == "float64") {
+		return nil, "floating-point results are not returned by the syscall package"
+	}
+	var args []string
+	for _, p := range c.params {
+		t := p.goType()
+		if !arch.floatArgs && (t == "float32" || t == "float64") {
+			return nil, "floating-point arguments are not passed by the syscall package"
+		}
+		switch {
+		case t == "string":
+			args = append(args, fmt.Sprintf("uintptr(unsafe.Pointer(cString(%s)))", p.name))
+		case t == "unsafe.Pointer":
+			args = append(args, fmt.Sprintf("uintptr(%s)", p.name))
+		case p.ptr > 0:
+			args = append(args, fmt.Sprintf("uintptr(unsafe.Pointer(%s))", p.name))
+		case t == "bool":
+			args = append(args, fmt.Sprintf("boolArg(%s)", p.name))
+		case t == "float32":
+			args = append(args, fmt.Sprintf("uintptr(math.Float32bits(%s))", p.name))
+		case t == "float64" && arch.wordSize == 32:
+			// 64-bit values take two words, low word first
+			args = append(args,
+				fmt.Sprintf("uintptr(uint32(math.Float64bits(%s)))", p.name),
+				fmt.Sprintf("uintptr(math.Float64bits(%s)>>32)", p.name))
+		case t == "float64":
+			args = append(args, fmt.Sprintf("uintptr(math.Float64bits(%s))", p.name))
+		case (t == "int64" || t == "uint64") && arch.wordSize == 32:
+			args = append(args,
+				fmt.Sprintf("uintptr(uint32(%s))", p.name),
+				fmt.Sprintf("uintptr(uint64(%s)>>32)", p.name))
+		default:
+			args = append(args, fmt.Sprintf("uintptr(%s)", p.name))
+		}
+	}
+	return args, ""
+}
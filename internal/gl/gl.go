@@ -0,0 +1,71 @@
+// Package gl is the OpenGL binding used by the platform packages.
+//
+// Most of the package is generated from the Khronos registry (gl.xml) by
+// gen.go: the enum constants, and a wrapper for each command of the selected
+// API, version and extensions. The function addresses are looked up lazily,
+// the first time each wrapper is called; calling a function that is not
+// available in the current context panics.
+//
+// Only OpenGL ES 3.2 (with the extensions listed below) is bound, whatever the
+// profile of the context: the platform packages restrict themselves to the
+// commands shared with desktop OpenGL, and applications bring their own binding
+// for the rest of the API, including the core-only entry points.
+//
+// gl.xml was rebuilt from the Khronos headers (see the comment at its top). The
+// upstream registry can be dropped in its place; go generate should then produce
+// the same binding.
+package gl
+
+//go:generate go run gen.go -api gles2 -version 3.2 -ext GL_KHR_debug,GL_EXT_sRGB_write_control -trim KHR,EXT -skip glDebugMessageCallback,glDebugMessageCallbackKHR
+
+import (
+	"github.com/cozely/platform/internal/sdl"
+)
+
+// procs holds the addresses of the functions already looked up.
+var procs [numProcs]uintptr
+
+var wasInit bool = false
+
+func WasInit() bool {
+	return wasInit
+}
+
+// Init prepares the binding for the current context. Function addresses are
+// looked up lazily, so this only checks that OpenGL is available.
+func Init() error {
+	err, _ := sdl.GLGetProcAddress("glGetString")
+	if err != nil {
+		return err
+	}
+
+	procs = [numProcs]uintptr{}
+
+	// Optional functions
+	glDebugMessageCallback = 0
+	for _, n := range debugNames {
+		if err, glDebugMessageCallback = sdl.GLGetProcAddress(n); err == nil {
+			break
+		}
+	}
+
+	wasInit = true
+
+	return nil
+}
+
+// proc returns the address of the function at index i in the proc table,
+// looking it up if necessary.
+func proc(i int) uintptr {
+	if p := procs[i]; p != 0 {
+		return p
+	}
+	for _, n := range procNames[i] {
+		err, p := sdl.GLGetProcAddress(n)
+		if err == nil && p != 0 {
+			procs[i] = p
+			return p
+		}
+	}
+	panic("gl: function " + procNames[i][0] + " not available")
+}
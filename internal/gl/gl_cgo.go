@@ -0,0 +1,28 @@
+// +build !windows
+
+package gl
+
+/*
+#include <stdint.h>
+
+typedef void (*pfnDebugProc)(unsigned int source, unsigned int type, unsigned int id, unsigned int severity, int length, const char *message, const void *userParam);
+typedef void (*pfnDebugMessageCallback)(pfnDebugProc callback, const void *userParam);
+
+extern void goDebugCallback(unsigned int, unsigned int, unsigned int, unsigned int, int, char *, void *);
+
+static void debugMessageCallback(uintptr_t fn, uintptr_t userParam) {
+	((pfnDebugMessageCallback)fn)((pfnDebugProc)goDebugCallback, (const void *)userParam);
+}
+*/
+import "C"
+
+// DebugMessageCallback installs f as the debug output callback of the current
+// context; userParam is passed back to f with each message.
+func DebugMessageCallback(f DebugProc, userParam uintptr) error {
+	if glDebugMessageCallback == 0 {
+		return ErrNoDebugOutput
+	}
+	debugProc = f
+	C.debugMessageCallback(C.uintptr_t(glDebugMessageCallback), C.uintptr_t(userParam))
+	return nil
+}
@@ -2,12 +2,10 @@ package gl
 
 import (
 	//"errors"
+	"bytes"
 	"syscall"
 	"unsafe"
-
 	//"golang.org/x/sys/windows"
-
-	"github.com/cozely/platform/internal/sdl"
 )
 
 /*
@@ -33,29 +31,54 @@ func getProcAddress(name string) (error, uintptr) {
 }
 */
 
-var (
-	glClearBufferv uintptr
-)
-
-var wasInit bool = false
+var debugCallback uintptr
 
-func WasInit() bool {
-	return wasInit
+func goDebugCallback(source, typ, id, severity, length, message, userParam uintptr) uintptr {
+	if debugProc == nil {
+		return 0
+	}
+	var m string
+	if int32(length) >= 0 {
+		m = string((*[1 << 30]byte)(unsafe.Pointer(message))[:int32(length):int32(length)])
+	} else {
+		m = goString(message)
+	}
+	debugProc(Enum(source), Enum(typ), uint32(id), Enum(severity), m, userParam)
+	return 0
 }
 
-func Init() error {
-	var err error
-
-	err, glClearBufferv = sdl.GLGetProcAddress("glClearBufferfv")
-	if err != nil {
-		return err
+// DebugMessageCallback installs f as the debug output callback of the current
+// context; userParam is passed back to f with each message.
+func DebugMessageCallback(f DebugProc, userParam uintptr) error {
+	if glDebugMessageCallback == 0 {
+		return ErrNoDebugOutput
+	}
+	if debugCallback == 0 {
+		debugCallback = syscall.NewCallback(goDebugCallback)
 	}
+	debugProc = f
+	syscall.Syscall(glDebugMessageCallback, 2, debugCallback, userParam, 0)
+	return nil
+}
 
-	wasInit = true
+func goString(p uintptr) string {
+	if p == 0 {
+		return ""
+	}
+	b := (*[1 << (30 - 1)]byte)(unsafe.Pointer(p))
+	size := bytes.IndexByte(b[:], 0)
+	return string(b[:size:size])
+}
 
-	return nil
+func cString(s string) *byte {
+	b := make([]byte, len(s)+1)
+	copy(b, s)
+	return &b[0]
 }
 
-func ClearBufferv(buffer Enum, drawBuffer int32, color *struct{ R, G, B, A float32 }) {
-	syscall.Syscall(glClearBufferv, 3, uintptr(buffer), uintptr(drawBuffer), uintptr(unsafe.Pointer(color)))
+func boolArg(b bool) uintptr {
+	if b {
+		return 1
+	}
+	return 0
 }
@@ -0,0 +1,728 @@
+// Code generated by "go run gen.go"; DO NOT EDIT.
+// API: gles2 3.2, extensions: GL_KHR_debug,GL_EXT_sRGB_write_control.
+
+package gl
+
+// Indices in the proc address table.
+const (
+	procActiveShaderProgram = iota
+	procActiveTexture
+	procAttachShader
+	procBeginQuery
+	procBeginTransformFeedback
+	procBindAttribLocation
+	procBindBuffer
+	procBindBufferBase
+	procBindBufferRange
+	procBindFramebuffer
+	procBindImageTexture
+	procBindProgramPipeline
+	procBindRenderbuffer
+	procBindSampler
+	procBindTexture
+	procBindTransformFeedback
+	procBindVertexArray
+	procBindVertexBuffer
+	procBlendBarrier
+	procBlendColor
+	procBlendEquation
+	procBlendEquationSeparate
+	procBlendEquationSeparatei
+	procBlendEquationi
+	procBlendFunc
+	procBlendFuncSeparate
+	procBlendFuncSeparatei
+	procBlendFunci
+	procBlitFramebuffer
+	procBufferData
+	procBufferSubData
+	procCheckFramebufferStatus
+	procClear
+	procClearBufferfi
+	procClearBufferfv
+	procClearBufferiv
+	procClearBufferuiv
+	procClearColor
+	procClearDepthf
+	procClearStencil
+	procClientWaitSync
+	procColorMask
+	procColorMaski
+	procCompileShader
+	procCompressedTexImage2D
+	procCompressedTexImage3D
+	procCompressedTexSubImage2D
+	procCompressedTexSubImage3D
+	procCopyBufferSubData
+	procCopyImageSubData
+	procCopyTexImage2D
+	procCopyTexSubImage2D
+	procCopyTexSubImage3D
+	procCreateProgram
+	procCreateShader
+	procCreateShaderProgramv
+	procCullFace
+	procDebugMessageControl
+	procDebugMessageInsert
+	procDeleteBuffers
+	procDeleteFramebuffers
+	procDeleteProgram
+	procDeleteProgramPipelines
+	procDeleteQueries
+	procDeleteRenderbuffers
+	procDeleteSamplers
+	procDeleteShader
+	procDeleteSync
+	procDeleteTextures
+	procDeleteTransformFeedbacks
+	procDeleteVertexArrays
+	procDepthFunc
+	procDepthMask
+	procDepthRangef
+	procDetachShader
+	procDisable
+	procDisableVertexAttribArray
+	procDisablei
+	procDispatchCompute
+	procDispatchComputeIndirect
+	procDrawArrays
+	procDrawArraysIndirect
+	procDrawArraysInstanced
+	procDrawBuffers
+	procDrawElements
+	procDrawElementsBaseVertex
+	procDrawElementsIndirect
+	procDrawElementsInstanced
+	procDrawElementsInstancedBaseVertex
+	procDrawRangeElements
+	procDrawRangeElementsBaseVertex
+	procEnable
+	procEnableVertexAttribArray
+	procEnablei
+	procEndQuery
+	procEndTransformFeedback
+	procFenceSync
+	procFinish
+	procFlush
+	procFlushMappedBufferRange
+	procFramebufferParameteri
+	procFramebufferRenderbuffer
+	procFramebufferTexture
+	procFramebufferTexture2D
+	procFramebufferTextureLayer
+	procFrontFace
+	procGenBuffers
+	procGenFramebuffers
+	procGenProgramPipelines
+	procGenQueries
+	procGenRenderbuffers
+	procGenSamplers
+	procGenTextures
+	procGenTransformFeedbacks
+	procGenVertexArrays
+	procGenerateMipmap
+	procGetActiveAttrib
+	procGetActiveUniform
+	procGetActiveUniformBlockName
+	procGetActiveUniformBlockiv
+	procGetActiveUniformsiv
+	procGetAttachedShaders
+	procGetAttribLocation
+	procGetBooleani_v
+	procGetBooleanv
+	procGetBufferParameteri64v
+	procGetBufferParameteriv
+	procGetBufferPointerv
+	procGetDebugMessageLog
+	procGetError
+	procGetFloatv
+	procGetFragDataLocation
+	procGetFramebufferAttachmentParameteriv
+	procGetFramebufferParameteriv
+	procGetGraphicsResetStatus
+	procGetInteger64i_v
+	procGetInteger64v
+	procGetIntegeri_v
+	procGetIntegerv
+	procGetInternalformativ
+	procGetMultisamplefv
+	procGetObjectLabel
+	procGetObjectPtrLabel
+	procGetPointerv
+	procGetProgramBinary
+	procGetProgramInfoLog
+	procGetProgramInterfaceiv
+	procGetProgramPipelineInfoLog
+	procGetProgramPipelineiv
+	procGetProgramResourceIndex
+	procGetProgramResourceLocation
+	procGetProgramResourceName
+	procGetProgramResourceiv
+	procGetProgramiv
+	procGetQueryObjectuiv
+	procGetQueryiv
+	procGetRenderbufferParameteriv
+	procGetSamplerParameterIiv
+	procGetSamplerParameterIuiv
+	procGetSamplerParameterfv
+	procGetSamplerParameteriv
+	procGetShaderInfoLog
+	procGetShaderPrecisionFormat
+	procGetShaderSource
+	procGetShaderiv
+	procGetString
+	procGetStringi
+	procGetSynciv
+	procGetTexLevelParameterfv
+	procGetTexLevelParameteriv
+	procGetTexParameterIiv
+	procGetTexParameterIuiv
+	procGetTexParameterfv
+	procGetTexParameteriv
+	procGetTransformFeedbackVarying
+	procGetUniformBlockIndex
+	procGetUniformIndices
+	procGetUniformLocation
+	procGetUniformfv
+	procGetUniformiv
+	procGetUniformuiv
+	procGetVertexAttribIiv
+	procGetVertexAttribIuiv
+	procGetVertexAttribPointerv
+	procGetVertexAttribfv
+	procGetVertexAttribiv
+	procGetnUniformfv
+	procGetnUniformiv
+	procGetnUniformuiv
+	procHint
+	procInvalidateFramebuffer
+	procInvalidateSubFramebuffer
+	procIsBuffer
+	procIsEnabled
+	procIsEnabledi
+	procIsFramebuffer
+	procIsProgram
+	procIsProgramPipeline
+	procIsQuery
+	procIsRenderbuffer
+	procIsSampler
+	procIsShader
+	procIsSync
+	procIsTexture
+	procIsTransformFeedback
+	procIsVertexArray
+	procLineWidth
+	procLinkProgram
+	procMapBufferRange
+	procMemoryBarrier
+	procMemoryBarrierByRegion
+	procMinSampleShading
+	procObjectLabel
+	procObjectPtrLabel
+	procPatchParameteri
+	procPauseTransformFeedback
+	procPixelStorei
+	procPolygonOffset
+	procPopDebugGroup
+	procPrimitiveBoundingBox
+	procProgramBinary
+	procProgramParameteri
+	procProgramUniform1f
+	procProgramUniform1fv
+	procProgramUniform1i
+	procProgramUniform1iv
+	procProgramUniform1ui
+	procProgramUniform1uiv
+	procProgramUniform2f
+	procProgramUniform2fv
+	procProgramUniform2i
+	procProgramUniform2iv
+	procProgramUniform2ui
+	procProgramUniform2uiv
+	procProgramUniform3f
+	procProgramUniform3fv
+	procProgramUniform3i
+	procProgramUniform3iv
+	procProgramUniform3ui
+	procProgramUniform3uiv
+	procProgramUniform4f
+	procProgramUniform4fv
+	procProgramUniform4i
+	procProgramUniform4iv
+	procProgramUniform4ui
+	procProgramUniform4uiv
+	procProgramUniformMatrix2fv
+	procProgramUniformMatrix2x3fv
+	procProgramUniformMatrix2x4fv
+	procProgramUniformMatrix3fv
+	procProgramUniformMatrix3x2fv
+	procProgramUniformMatrix3x4fv
+	procProgramUniformMatrix4fv
+	procProgramUniformMatrix4x2fv
+	procProgramUniformMatrix4x3fv
+	procPushDebugGroup
+	procReadBuffer
+	procReadPixels
+	procReadnPixels
+	procReleaseShaderCompiler
+	procRenderbufferStorage
+	procRenderbufferStorageMultisample
+	procResumeTransformFeedback
+	procSampleCoverage
+	procSampleMaski
+	procSamplerParameterIiv
+	procSamplerParameterIuiv
+	procSamplerParameterf
+	procSamplerParameterfv
+	procSamplerParameteri
+	procSamplerParameteriv
+	procScissor
+	procShaderBinary
+	procShaderSource
+	procStencilFunc
+	procStencilFuncSeparate
+	procStencilMask
+	procStencilMaskSeparate
+	procStencilOp
+	procStencilOpSeparate
+	procTexBuffer
+	procTexBufferRange
+	procTexImage2D
+	procTexImage3D
+	procTexParameterIiv
+	procTexParameterIuiv
+	procTexParameterf
+	procTexParameterfv
+	procTexParameteri
+	procTexParameteriv
+	procTexStorage2D
+	procTexStorage2DMultisample
+	procTexStorage3D
+	procTexStorage3DMultisample
+	procTexSubImage2D
+	procTexSubImage3D
+	procTransformFeedbackVaryings
+	procUniform1f
+	procUniform1fv
+	procUniform1i
+	procUniform1iv
+	procUniform1ui
+	procUniform1uiv
+	procUniform2f
+	procUniform2fv
+	procUniform2i
+	procUniform2iv
+	procUniform2ui
+	procUniform2uiv
+	procUniform3f
+	procUniform3fv
+	procUniform3i
+	procUniform3iv
+	procUniform3ui
+	procUniform3uiv
+	procUniform4f
+	procUniform4fv
+	procUniform4i
+	procUniform4iv
+	procUniform4ui
+	procUniform4uiv
+	procUniformBlockBinding
+	procUniformMatrix2fv
+	procUniformMatrix2x3fv
+	procUniformMatrix2x4fv
+	procUniformMatrix3fv
+	procUniformMatrix3x2fv
+	procUniformMatrix3x4fv
+	procUniformMatrix4fv
+	procUniformMatrix4x2fv
+	procUniformMatrix4x3fv
+	procUnmapBuffer
+	procUseProgram
+	procUseProgramStages
+	procValidateProgram
+	procValidateProgramPipeline
+	procVertexAttrib1f
+	procVertexAttrib1fv
+	procVertexAttrib2f
+	procVertexAttrib2fv
+	procVertexAttrib3f
+	procVertexAttrib3fv
+	procVertexAttrib4f
+	procVertexAttrib4fv
+	procVertexAttribBinding
+	procVertexAttribDivisor
+	procVertexAttribFormat
+	procVertexAttribI4i
+	procVertexAttribI4iv
+	procVertexAttribI4ui
+	procVertexAttribI4uiv
+	procVertexAttribIFormat
+	procVertexAttribIPointer
+	procVertexAttribPointer
+	procVertexBindingDivisor
+	procViewport
+	procWaitSync
+	numProcs
+)
+
+// procNames lists, for each entry of the proc address table, the names under
+// which the function is looked up.
+var procNames = [numProcs][]string{
+	procActiveShaderProgram:                 {"glActiveShaderProgram"},
+	procActiveTexture:                       {"glActiveTexture"},
+	procAttachShader:                        {"glAttachShader"},
+	procBeginQuery:                          {"glBeginQuery"},
+	procBeginTransformFeedback:              {"glBeginTransformFeedback"},
+	procBindAttribLocation:                  {"glBindAttribLocation"},
+	procBindBuffer:                          {"glBindBuffer"},
+	procBindBufferBase:                      {"glBindBufferBase"},
+	procBindBufferRange:                     {"glBindBufferRange"},
+	procBindFramebuffer:                     {"glBindFramebuffer"},
+	procBindImageTexture:                    {"glBindImageTexture"},
+	procBindProgramPipeline:                 {"glBindProgramPipeline"},
+	procBindRenderbuffer:                    {"glBindRenderbuffer"},
+	procBindSampler:                         {"glBindSampler"},
+	procBindTexture:                         {"glBindTexture"},
+	procBindTransformFeedback:               {"glBindTransformFeedback"},
+	procBindVertexArray:                     {"glBindVertexArray"},
+	procBindVertexBuffer:                    {"glBindVertexBuffer"},
+	procBlendBarrier:                        {"glBlendBarrier"},
+	procBlendColor:                          {"glBlendColor"},
+	procBlendEquation:                       {"glBlendEquation"},
+	procBlendEquationSeparate:               {"glBlendEquationSeparate"},
+	procBlendEquationSeparatei:              {"glBlendEquationSeparatei"},
+	procBlendEquationi:                      {"glBlendEquationi"},
+	procBlendFunc:                           {"glBlendFunc"},
+	procBlendFuncSeparate:                   {"glBlendFuncSeparate"},
+	procBlendFuncSeparatei:                  {"glBlendFuncSeparatei"},
+	procBlendFunci:                          {"glBlendFunci"},
+	procBlitFramebuffer:                     {"glBlitFramebuffer"},
+	procBufferData:                          {"glBufferData"},
+	procBufferSubData:                       {"glBufferSubData"},
+	procCheckFramebufferStatus:              {"glCheckFramebufferStatus"},
+	procClear:                               {"glClear"},
+	procClearBufferfi:                       {"glClearBufferfi"},
+	procClearBufferfv:                       {"glClearBufferfv"},
+	procClearBufferiv:                       {"glClearBufferiv"},
+	procClearBufferuiv:                      {"glClearBufferuiv"},
+	procClearColor:                          {"glClearColor"},
+	procClearDepthf:                         {"glClearDepthf"},
+	procClearStencil:                        {"glClearStencil"},
+	procClientWaitSync:                      {"glClientWaitSync"},
+	procColorMask:                           {"glColorMask"},
+	procColorMaski:                          {"glColorMaski"},
+	procCompileShader:                       {"glCompileShader"},
+	procCompressedTexImage2D:                {"glCompressedTexImage2D"},
+	procCompressedTexImage3D:                {"glCompressedTexImage3D"},
+	procCompressedTexSubImage2D:             {"glCompressedTexSubImage2D"},
+	procCompressedTexSubImage3D:             {"glCompressedTexSubImage3D"},
+	procCopyBufferSubData:                   {"glCopyBufferSubData"},
+	procCopyImageSubData:                    {"glCopyImageSubData"},
+	procCopyTexImage2D:                      {"glCopyTexImage2D"},
+	procCopyTexSubImage2D:                   {"glCopyTexSubImage2D"},
+	procCopyTexSubImage3D:                   {"glCopyTexSubImage3D"},
+	procCreateProgram:                       {"glCreateProgram"},
+	procCreateShader:                        {"glCreateShader"},
+	procCreateShaderProgramv:                {"glCreateShaderProgramv"},
+	procCullFace:                            {"glCullFace"},
+	procDebugMessageControl:                 {"glDebugMessageControl", "glDebugMessageControlKHR"},
+	procDebugMessageInsert:                  {"glDebugMessageInsert", "glDebugMessageInsertKHR"},
+	procDeleteBuffers:                       {"glDeleteBuffers"},
+	procDeleteFramebuffers:                  {"glDeleteFramebuffers"},
+	procDeleteProgram:                       {"glDeleteProgram"},
+	procDeleteProgramPipelines:              {"glDeleteProgramPipelines"},
+	procDeleteQueries:                       {"glDeleteQueries"},
+	procDeleteRenderbuffers:                 {"glDeleteRenderbuffers"},
+	procDeleteSamplers:                      {"glDeleteSamplers"},
+	procDeleteShader:                        {"glDeleteShader"},
+	procDeleteSync:                          {"glDeleteSync"},
+	procDeleteTextures:                      {"glDeleteTextures"},
+	procDeleteTransformFeedbacks:            {"glDeleteTransformFeedbacks"},
+	procDeleteVertexArrays:                  {"glDeleteVertexArrays"},
+	procDepthFunc:                           {"glDepthFunc"},
+	procDepthMask:                           {"glDepthMask"},
+	procDepthRangef:                         {"glDepthRangef"},
+	procDetachShader:                        {"glDetachShader"},
+	procDisable:                             {"glDisable"},
+	procDisableVertexAttribArray:            {"glDisableVertexAttribArray"},
+	procDisablei:                            {"glDisablei"},
+	procDispatchCompute:                     {"glDispatchCompute"},
+	procDispatchComputeIndirect:             {"glDispatchComputeIndirect"},
+	procDrawArrays:                          {"glDrawArrays"},
+	procDrawArraysIndirect:                  {"glDrawArraysIndirect"},
+	procDrawArraysInstanced:                 {"glDrawArraysInstanced"},
+	procDrawBuffers:                         {"glDrawBuffers"},
+	procDrawElements:                        {"glDrawElements"},
+	procDrawElementsBaseVertex:              {"glDrawElementsBaseVertex"},
+	procDrawElementsIndirect:                {"glDrawElementsIndirect"},
+	procDrawElementsInstanced:               {"glDrawElementsInstanced"},
+	procDrawElementsInstancedBaseVertex:     {"glDrawElementsInstancedBaseVertex"},
+	procDrawRangeElements:                   {"glDrawRangeElements"},
+	procDrawRangeElementsBaseVertex:         {"glDrawRangeElementsBaseVertex"},
+	procEnable:                              {"glEnable"},
+	procEnableVertexAttribArray:             {"glEnableVertexAttribArray"},
+	procEnablei:                             {"glEnablei"},
+	procEndQuery:                            {"glEndQuery"},
+	procEndTransformFeedback:                {"glEndTransformFeedback"},
+	procFenceSync:                           {"glFenceSync"},
+	procFinish:                              {"glFinish"},
+	procFlush:                               {"glFlush"},
+	procFlushMappedBufferRange:              {"glFlushMappedBufferRange"},
+	procFramebufferParameteri:               {"glFramebufferParameteri"},
+	procFramebufferRenderbuffer:             {"glFramebufferRenderbuffer"},
+	procFramebufferTexture:                  {"glFramebufferTexture"},
+	procFramebufferTexture2D:                {"glFramebufferTexture2D"},
+	procFramebufferTextureLayer:             {"glFramebufferTextureLayer"},
+	procFrontFace:                           {"glFrontFace"},
+	procGenBuffers:                          {"glGenBuffers"},
+	procGenFramebuffers:                     {"glGenFramebuffers"},
+	procGenProgramPipelines:                 {"glGenProgramPipelines"},
+	procGenQueries:                          {"glGenQueries"},
+	procGenRenderbuffers:                    {"glGenRenderbuffers"},
+	procGenSamplers:                         {"glGenSamplers"},
+	procGenTextures:                         {"glGenTextures"},
+	procGenTransformFeedbacks:               {"glGenTransformFeedbacks"},
+	procGenVertexArrays:                     {"glGenVertexArrays"},
+	procGenerateMipmap:                      {"glGenerateMipmap"},
+	procGetActiveAttrib:                     {"glGetActiveAttrib"},
+	procGetActiveUniform:                    {"glGetActiveUniform"},
+	procGetActiveUniformBlockName:           {"glGetActiveUniformBlockName"},
+	procGetActiveUniformBlockiv:             {"glGetActiveUniformBlockiv"},
+	procGetActiveUniformsiv:                 {"glGetActiveUniformsiv"},
+	procGetAttachedShaders:                  {"glGetAttachedShaders"},
+	procGetAttribLocation:                   {"glGetAttribLocation"},
+	procGetBooleani_v:                       {"glGetBooleani_v"},
+	procGetBooleanv:                         {"glGetBooleanv"},
+	procGetBufferParameteri64v:              {"glGetBufferParameteri64v"},
+	procGetBufferParameteriv:                {"glGetBufferParameteriv"},
+	procGetBufferPointerv:                   {"glGetBufferPointerv"},
+	procGetDebugMessageLog:                  {"glGetDebugMessageLog", "glGetDebugMessageLogKHR"},
+	procGetError:                            {"glGetError"},
+	procGetFloatv:                           {"glGetFloatv"},
+	procGetFragDataLocation:                 {"glGetFragDataLocation"},
+	procGetFramebufferAttachmentParameteriv: {"glGetFramebufferAttachmentParameteriv"},
+	procGetFramebufferParameteriv:           {"glGetFramebufferParameteriv"},
+	procGetGraphicsResetStatus:              {"glGetGraphicsResetStatus"},
+	procGetInteger64i_v:                     {"glGetInteger64i_v"},
+	procGetInteger64v:                       {"glGetInteger64v"},
+	procGetIntegeri_v:                       {"glGetIntegeri_v"},
+	procGetIntegerv:                         {"glGetIntegerv"},
+	procGetInternalformativ:                 {"glGetInternalformativ"},
+	procGetMultisamplefv:                    {"glGetMultisamplefv"},
+	procGetObjectLabel:                      {"glGetObjectLabel", "glGetObjectLabelKHR"},
+	procGetObjectPtrLabel:                   {"glGetObjectPtrLabel", "glGetObjectPtrLabelKHR"},
+	procGetPointerv:                         {"glGetPointerv", "glGetPointervKHR"},
+	procGetProgramBinary:                    {"glGetProgramBinary"},
+	procGetProgramInfoLog:                   {"glGetProgramInfoLog"},
+	procGetProgramInterfaceiv:               {"glGetProgramInterfaceiv"},
+	procGetProgramPipelineInfoLog:           {"glGetProgramPipelineInfoLog"},
+	procGetProgramPipelineiv:                {"glGetProgramPipelineiv"},
+	procGetProgramResourceIndex:             {"glGetProgramResourceIndex"},
+	procGetProgramResourceLocation:          {"glGetProgramResourceLocation"},
+	procGetProgramResourceName:              {"glGetProgramResourceName"},
+	procGetProgramResourceiv:                {"glGetProgramResourceiv"},
+	procGetProgramiv:                        {"glGetProgramiv"},
+	procGetQueryObjectuiv:                   {"glGetQueryObjectuiv"},
+	procGetQueryiv:                          {"glGetQueryiv"},
+	procGetRenderbufferParameteriv:          {"glGetRenderbufferParameteriv"},
+	procGetSamplerParameterIiv:              {"glGetSamplerParameterIiv"},
+	procGetSamplerParameterIuiv:             {"glGetSamplerParameterIuiv"},
+	procGetSamplerParameterfv:               {"glGetSamplerParameterfv"},
+	procGetSamplerParameteriv:               {"glGetSamplerParameteriv"},
+	procGetShaderInfoLog:                    {"glGetShaderInfoLog"},
+	procGetShaderPrecisionFormat:            {"glGetShaderPrecisionFormat"},
+	procGetShaderSource:                     {"glGetShaderSource"},
+	procGetShaderiv:                         {"glGetShaderiv"},
+	procGetString:                           {"glGetString"},
+	procGetStringi:                          {"glGetStringi"},
+	procGetSynciv:                           {"glGetSynciv"},
+	procGetTexLevelParameterfv:              {"glGetTexLevelParameterfv"},
+	procGetTexLevelParameteriv:              {"glGetTexLevelParameteriv"},
+	procGetTexParameterIiv:                  {"glGetTexParameterIiv"},
+	procGetTexParameterIuiv:                 {"glGetTexParameterIuiv"},
+	procGetTexParameterfv:                   {"glGetTexParameterfv"},
+	procGetTexParameteriv:                   {"glGetTexParameteriv"},
+	procGetTransformFeedbackVarying:         {"glGetTransformFeedbackVarying"},
+	procGetUniformBlockIndex:                {"glGetUniformBlockIndex"},
+	procGetUniformIndices:                   {"glGetUniformIndices"},
+	procGetUniformLocation:                  {"glGetUniformLocation"},
+	procGetUniformfv:                        {"glGetUniformfv"},
+	procGetUniformiv:                        {"glGetUniformiv"},
+	procGetUniformuiv:                       {"glGetUniformuiv"},
+	procGetVertexAttribIiv:                  {"glGetVertexAttribIiv"},
+	procGetVertexAttribIuiv:                 {"glGetVertexAttribIuiv"},
+	procGetVertexAttribPointerv:             {"glGetVertexAttribPointerv"},
+	procGetVertexAttribfv:                   {"glGetVertexAttribfv"},
+	procGetVertexAttribiv:                   {"glGetVertexAttribiv"},
+	procGetnUniformfv:                       {"glGetnUniformfv"},
+	procGetnUniformiv:                       {"glGetnUniformiv"},
+	procGetnUniformuiv:                      {"glGetnUniformuiv"},
+	procHint:                                {"glHint"},
+	procInvalidateFramebuffer:               {"glInvalidateFramebuffer"},
+	procInvalidateSubFramebuffer:            {"glInvalidateSubFramebuffer"},
+	procIsBuffer:                            {"glIsBuffer"},
+	procIsEnabled:                           {"glIsEnabled"},
+	procIsEnabledi:                          {"glIsEnabledi"},
+	procIsFramebuffer:                       {"glIsFramebuffer"},
+	procIsProgram:                           {"glIsProgram"},
+	procIsProgramPipeline:                   {"glIsProgramPipeline"},
+	procIsQuery:                             {"glIsQuery"},
+	procIsRenderbuffer:                      {"glIsRenderbuffer"},
+	procIsSampler:                           {"glIsSampler"},
+	procIsShader:                            {"glIsShader"},
+	procIsSync:                              {"glIsSync"},
+	procIsTexture:                           {"glIsTexture"},
+	procIsTransformFeedback:                 {"glIsTransformFeedback"},
+	procIsVertexArray:                       {"glIsVertexArray"},
+	procLineWidth:                           {"glLineWidth"},
+	procLinkProgram:                         {"glLinkProgram"},
+	procMapBufferRange:                      {"glMapBufferRange"},
+	procMemoryBarrier:                       {"glMemoryBarrier"},
+	procMemoryBarrierByRegion:               {"glMemoryBarrierByRegion"},
+	procMinSampleShading:                    {"glMinSampleShading"},
+	procObjectLabel:                         {"glObjectLabel", "glObjectLabelKHR"},
+	procObjectPtrLabel:                      {"glObjectPtrLabel", "glObjectPtrLabelKHR"},
+	procPatchParameteri:                     {"glPatchParameteri"},
+	procPauseTransformFeedback:              {"glPauseTransformFeedback"},
+	procPixelStorei:                         {"glPixelStorei"},
+	procPolygonOffset:                       {"glPolygonOffset"},
+	procPopDebugGroup:                       {"glPopDebugGroup", "glPopDebugGroupKHR"},
+	procPrimitiveBoundingBox:                {"glPrimitiveBoundingBox"},
+	procProgramBinary:                       {"glProgramBinary"},
+	procProgramParameteri:                   {"glProgramParameteri"},
+	procProgramUniform1f:                    {"glProgramUniform1f"},
+	procProgramUniform1fv:                   {"glProgramUniform1fv"},
+	procProgramUniform1i:                    {"glProgramUniform1i"},
+	procProgramUniform1iv:                   {"glProgramUniform1iv"},
+	procProgramUniform1ui:                   {"glProgramUniform1ui"},
+	procProgramUniform1uiv:                  {"glProgramUniform1uiv"},
+	procProgramUniform2f:                    {"glProgramUniform2f"},
+	procProgramUniform2fv:                   {"glProgramUniform2fv"},
+	procProgramUniform2i:                    {"glProgramUniform2i"},
+	procProgramUniform2iv:                   {"glProgramUniform2iv"},
+	procProgramUniform2ui:                   {"glProgramUniform2ui"},
+	procProgramUniform2uiv:                  {"glProgramUniform2uiv"},
+	procProgramUniform3f:                    {"glProgramUniform3f"},
+	procProgramUniform3fv:                   {"glProgramUniform3fv"},
+	procProgramUniform3i:                    {"glProgramUniform3i"},
+	procProgramUniform3iv:                   {"glProgramUniform3iv"},
+	procProgramUniform3ui:                   {"glProgramUniform3ui"},
+	procProgramUniform3uiv:                  {"glProgramUniform3uiv"},
+	procProgramUniform4f:                    {"glProgramUniform4f"},
+	procProgramUniform4fv:                   {"glProgramUniform4fv"},
+	procProgramUniform4i:                    {"glProgramUniform4i"},
+	procProgramUniform4iv:                   {"glProgramUniform4iv"},
+	procProgramUniform4ui:                   {"glProgramUniform4ui"},
+	procProgramUniform4uiv:                  {"glProgramUniform4uiv"},
+	procProgramUniformMatrix2fv:             {"glProgramUniformMatrix2fv"},
+	procProgramUniformMatrix2x3fv:           {"glProgramUniformMatrix2x3fv"},
+	procProgramUniformMatrix2x4fv:           {"glProgramUniformMatrix2x4fv"},
+	procProgramUniformMatrix3fv:             {"glProgramUniformMatrix3fv"},
+	procProgramUniformMatrix3x2fv:           {"glProgramUniformMatrix3x2fv"},
+	procProgramUniformMatrix3x4fv:           {"glProgramUniformMatrix3x4fv"},
+	procProgramUniformMatrix4fv:             {"glProgramUniformMatrix4fv"},
+	procProgramUniformMatrix4x2fv:           {"glProgramUniformMatrix4x2fv"},
+	procProgramUniformMatrix4x3fv:           {"glProgramUniformMatrix4x3fv"},
+	procPushDebugGroup:                      {"glPushDebugGroup", "glPushDebugGroupKHR"},
+	procReadBuffer:                          {"glReadBuffer"},
+	procReadPixels:                          {"glReadPixels"},
+	procReadnPixels:                         {"glReadnPixels"},
+	procReleaseShaderCompiler:               {"glReleaseShaderCompiler"},
+	procRenderbufferStorage:                 {"glRenderbufferStorage"},
+	procRenderbufferStorageMultisample:      {"glRenderbufferStorageMultisample"},
+	procResumeTransformFeedback:             {"glResumeTransformFeedback"},
+	procSampleCoverage:                      {"glSampleCoverage"},
+	procSampleMaski:                         {"glSampleMaski"},
+	procSamplerParameterIiv:                 {"glSamplerParameterIiv"},
+	procSamplerParameterIuiv:                {"glSamplerParameterIuiv"},
+	procSamplerParameterf:                   {"glSamplerParameterf"},
+	procSamplerParameterfv:                  {"glSamplerParameterfv"},
+	procSamplerParameteri:                   {"glSamplerParameteri"},
+	procSamplerParameteriv:                  {"glSamplerParameteriv"},
+	procScissor:                             {"glScissor"},
+	procShaderBinary:                        {"glShaderBinary"},
+	procShaderSource:                        {"glShaderSource"},
+	procStencilFunc:                         {"glStencilFunc"},
+	procStencilFuncSeparate:                 {"glStencilFuncSeparate"},
+	procStencilMask:                         {"glStencilMask"},
+	procStencilMaskSeparate:                 {"glStencilMaskSeparate"},
+	procStencilOp:                           {"glStencilOp"},
+	procStencilOpSeparate:                   {"glStencilOpSeparate"},
+	procTexBuffer:                           {"glTexBuffer"},
+	procTexBufferRange:                      {"glTexBufferRange"},
+	procTexImage2D:                          {"glTexImage2D"},
+	procTexImage3D:                          {"glTexImage3D"},
+	procTexParameterIiv:                     {"glTexParameterIiv"},
+	procTexParameterIuiv:                    {"glTexParameterIuiv"},
+	procTexParameterf:                       {"glTexParameterf"},
+	procTexParameterfv:                      {"glTexParameterfv"},
+	procTexParameteri:                       {"glTexParameteri"},
+	procTexParameteriv:                      {"glTexParameteriv"},
+	procTexStorage2D:                        {"glTexStorage2D"},
+	procTexStorage2DMultisample:             {"glTexStorage2DMultisample"},
+	procTexStorage3D:                        {"glTexStorage3D"},
+	procTexStorage3DMultisample:             {"glTexStorage3DMultisample"},
+	procTexSubImage2D:                       {"glTexSubImage2D"},
+	procTexSubImage3D:                       {"glTexSubImage3D"},
+	procTransformFeedbackVaryings:           {"glTransformFeedbackVaryings"},
+	procUniform1f:                           {"glUniform1f"},
+	procUniform1fv:                          {"glUniform1fv"},
+	procUniform1i:                           {"glUniform1i"},
+	procUniform1iv:                          {"glUniform1iv"},
+	procUniform1ui:                          {"glUniform1ui"},
+	procUniform1uiv:                         {"glUniform1uiv"},
+	procUniform2f:                           {"glUniform2f"},
+	procUniform2fv:                          {"glUniform2fv"},
+	procUniform2i:                           {"glUniform2i"},
+	procUniform2iv:                          {"glUniform2iv"},
+	procUniform2ui:                          {"glUniform2ui"},
+	procUniform2uiv:                         {"glUniform2uiv"},
+	procUniform3f:                           {"glUniform3f"},
+	procUniform3fv:                          {"glUniform3fv"},
+	procUniform3i:                           {"glUniform3i"},
+	procUniform3iv:                          {"glUniform3iv"},
+	procUniform3ui:                          {"glUniform3ui"},
+	procUniform3uiv:                         {"glUniform3uiv"},
+	procUniform4f:                           {"glUniform4f"},
+	procUniform4fv:                          {"glUniform4fv"},
+	procUniform4i:                           {"glUniform4i"},
+	procUniform4iv:                          {"glUniform4iv"},
+	procUniform4ui:                          {"glUniform4ui"},
+	procUniform4uiv:                         {"glUniform4uiv"},
+	procUniformBlockBinding:                 {"glUniformBlockBinding"},
+	procUniformMatrix2fv:                    {"glUniformMatrix2fv"},
+	procUniformMatrix2x3fv:                  {"glUniformMatrix2x3fv"},
+	procUniformMatrix2x4fv:                  {"glUniformMatrix2x4fv"},
+	procUniformMatrix3fv:                    {"glUniformMatrix3fv"},
+	procUniformMatrix3x2fv:                  {"glUniformMatrix3x2fv"},
+	procUniformMatrix3x4fv:                  {"glUniformMatrix3x4fv"},
+	procUniformMatrix4fv:                    {"glUniformMatrix4fv"},
+	procUniformMatrix4x2fv:                  {"glUniformMatrix4x2fv"},
+	procUniformMatrix4x3fv:                  {"glUniformMatrix4x3fv"},
+	procUnmapBuffer:                         {"glUnmapBuffer"},
+	procUseProgram:                          {"glUseProgram"},
+	procUseProgramStages:                    {"glUseProgramStages"},
+	procValidateProgram:                     {"glValidateProgram"},
+	procValidateProgramPipeline:             {"glValidateProgramPipeline"},
+	procVertexAttrib1f:                      {"glVertexAttrib1f"},
+	procVertexAttrib1fv:                     {"glVertexAttrib1fv"},
+	procVertexAttrib2f:                      {"glVertexAttrib2f"},
+	procVertexAttrib2fv:                     {"glVertexAttrib2fv"},
+	procVertexAttrib3f:                      {"glVertexAttrib3f"},
+	procVertexAttrib3fv:                     {"glVertexAttrib3fv"},
+	procVertexAttrib4f:                      {"glVertexAttrib4f"},
+	procVertexAttrib4fv:                     {"glVertexAttrib4fv"},
+	procVertexAttribBinding:                 {"glVertexAttribBinding"},
+	procVertexAttribDivisor:                 {"glVertexAttribDivisor"},
+	procVertexAttribFormat:                  {"glVertexAttribFormat"},
+	procVertexAttribI4i:                     {"glVertexAttribI4i"},
+	procVertexAttribI4iv:                    {"glVertexAttribI4iv"},
+	procVertexAttribI4ui:                    {"glVertexAttribI4ui"},
+	procVertexAttribI4uiv:                   {"glVertexAttribI4uiv"},
+	procVertexAttribIFormat:                 {"glVertexAttribIFormat"},
+	procVertexAttribIPointer:                {"glVertexAttribIPointer"},
+	procVertexAttribPointer:                 {"glVertexAttribPointer"},
+	procVertexBindingDivisor:                {"glVertexBindingDivisor"},
+	procViewport:                            {"glViewport"},
+	procWaitSync:                            {"glWaitSync"},
+}
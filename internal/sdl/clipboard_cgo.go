@@ -0,0 +1,74 @@
+// +build !windows
+
+package sdl
+
+/*
+#include "sdl.h"
+
+// The primary selection functions appeared in SDL 2.26
+#if SDL_VERSION_ATLEAST(2, 26, 0)
+static int primarySelectionSupported = 1;
+#else
+static int primarySelectionSupported = 0;
+static char *SDL_GetPrimarySelectionText(void) { return NULL; }
+static int SDL_SetPrimarySelectionText(const char *text) { return -1; }
+static SDL_bool SDL_HasPrimarySelectionText(void) { return SDL_FALSE; }
+#endif
+*/
+import "C"
+
+import "unsafe"
+
+func GetClipboardText() (string, error) {
+	t := C.SDL_GetClipboardText()
+	if t == nil {
+		return "", GetError()
+	}
+	defer C.SDL_free(unsafe.Pointer(t))
+	return C.GoString(t), nil
+}
+
+func SetClipboardText(text string) error {
+	t := C.CString(text)
+	defer C.free(unsafe.Pointer(t))
+
+	errc := C.SDL_SetClipboardText(t)
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func HasClipboardText() bool {
+	return C.SDL_HasClipboardText() == C.SDL_TRUE
+}
+
+// PrimarySelectionSupported returns true if the SDL library has the primary
+// selection functions (SDL 2.26 or later).
+func PrimarySelectionSupported() bool {
+	return C.primarySelectionSupported != 0
+}
+
+func GetPrimarySelectionText() (string, error) {
+	t := C.SDL_GetPrimarySelectionText()
+	if t == nil {
+		return "", GetError()
+	}
+	defer C.SDL_free(unsafe.Pointer(t))
+	return C.GoString(t), nil
+}
+
+func SetPrimarySelectionText(text string) error {
+	t := C.CString(text)
+	defer C.free(unsafe.Pointer(t))
+
+	errc := C.SDL_SetPrimarySelectionText(t)
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func HasPrimarySelectionText() bool {
+	return C.SDL_HasPrimarySelectionText() == C.SDL_TRUE
+}
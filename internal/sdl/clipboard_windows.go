@@ -0,0 +1,61 @@
+package sdl
+
+var (
+	SDL_free                    = dll.NewProc("SDL_free")
+	SDL_GetClipboardText        = dll.NewProc("SDL_GetClipboardText")
+	SDL_SetClipboardText        = dll.NewProc("SDL_SetClipboardText")
+	SDL_HasClipboardText        = dll.NewProc("SDL_HasClipboardText")
+	SDL_GetPrimarySelectionText = dll.NewProc("SDL_GetPrimarySelectionText")
+	SDL_SetPrimarySelectionText = dll.NewProc("SDL_SetPrimarySelectionText")
+	SDL_HasPrimarySelectionText = dll.NewProc("SDL_HasPrimarySelectionText")
+)
+
+func GetClipboardText() (string, error) {
+	t, _, _ := SDL_GetClipboardText.Call()
+	if t == 0 {
+		return "", GetError()
+	}
+	defer SDL_free.Call(t)
+	return goString(t), nil
+}
+
+func SetClipboardText(text string) error {
+	errc, _, _ := SDL_SetClipboardText.Call(cString(text))
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func HasClipboardText() bool {
+	r, _, _ := SDL_HasClipboardText.Call()
+	return r&0xFF != 0
+}
+
+// PrimarySelectionSupported returns true if the SDL library has the primary
+// selection functions (SDL 2.26 or later).
+func PrimarySelectionSupported() bool {
+	return SDL_GetPrimarySelectionText.Find() == nil
+}
+
+func GetPrimarySelectionText() (string, error) {
+	t, _, _ := SDL_GetPrimarySelectionText.Call()
+	if t == 0 {
+		return "", GetError()
+	}
+	defer SDL_free.Call(t)
+	return goString(t), nil
+}
+
+func SetPrimarySelectionText(text string) error {
+	errc, _, _ := SDL_SetPrimarySelectionText.Call(cString(text))
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func HasPrimarySelectionText() bool {
+	r, _, _ := SDL_HasPrimarySelectionText.Call()
+	return r&0xFF != 0
+}
@@ -32,3 +32,10 @@ func GetError() error {
 func ClearError() {
 	SDL_ClearError.Call()
 }
+
+func boolArg(b bool) uintptr {
+	if b {
+		return 1
+	}
+	return 0
+}
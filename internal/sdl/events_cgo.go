@@ -0,0 +1,33 @@
+// +build !windows
+
+package sdl
+
+//#include "sdl.h"
+import "C"
+
+import "unsafe"
+
+func PollEvent(e *Event) bool {
+	return C.SDL_PollEvent((*C.SDL_Event)(unsafe.Pointer(e))) != 0
+}
+
+func PumpEvents() {
+	C.SDL_PumpEvents()
+}
+
+func EventState(t EventType, state int32) uint8 {
+	return uint8(C.SDL_EventState(C.Uint32(t), C.int(state)))
+}
+
+// TakeDropFile returns the file name or text of a drop event, and frees the
+// string allocated by SDL.
+func TakeDropFile(e *DropEvent) string {
+	if e.File == 0 {
+		return ""
+	}
+	p := (*C.char)(unsafe.Pointer(e.File))
+	s := C.GoString(p)
+	C.SDL_free(unsafe.Pointer(p))
+	e.File = 0
+	return s
+}
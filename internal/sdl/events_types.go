@@ -0,0 +1,134 @@
+package sdl
+
+import "unsafe"
+
+// The types of events that can be delivered.
+type EventType uint32
+
+const (
+	EventFirst EventType = 0 // unused
+
+	// Application events
+	EventQuit EventType = 0x100 // user-requested quit
+
+	// Window events
+	EventWindow EventType = 0x200 // window state change
+	EventSysWM  EventType = 0x201 // system specific event
+
+	// Keyboard events
+	EventKeyDown       EventType = 0x300 // key pressed
+	EventKeyUp         EventType = 0x301 // key released
+	EventTextEditing   EventType = 0x302 // keyboard text editing (composition)
+	EventTextInput     EventType = 0x303 // keyboard text input
+	EventKeymapChanged EventType = 0x304 // keymap changed due to a system event
+
+	// Mouse events
+	EventMouseMotion     EventType = 0x400 // mouse moved
+	EventMouseButtonDown EventType = 0x401 // mouse button pressed
+	EventMouseButtonUp   EventType = 0x402 // mouse button released
+	EventMouseWheel      EventType = 0x403 // mouse wheel motion
+
+	// Joystick events
+	EventJoyAxisMotion    EventType = 0x600 // joystick axis motion
+	EventJoyBallMotion    EventType = 0x601 // joystick trackball motion
+	EventJoyHatMotion     EventType = 0x602 // joystick hat position change
+	EventJoyButtonDown    EventType = 0x603 // joystick button pressed
+	EventJoyButtonUp      EventType = 0x604 // joystick button released
+	EventJoyDeviceAdded   EventType = 0x605 // a new joystick has been inserted into the system
+	EventJoyDeviceRemoved EventType = 0x606 // an opened joystick has been removed
+
+	// Game controller events
+	EventControllerAxisMotion     EventType = 0x650 // game controller axis motion
+	EventControllerButtonDown     EventType = 0x651 // game controller button pressed
+	EventControllerButtonUp       EventType = 0x652 // game controller button released
+	EventControllerDeviceAdded    EventType = 0x653 // a new game controller has been inserted into the system
+	EventControllerDeviceRemoved  EventType = 0x654 // an opened game controller has been removed
+	EventControllerDeviceRemapped EventType = 0x655 // the controller mapping was updated
+
+	// Clipboard events
+	EventClipboardUpdate EventType = 0x900 // the clipboard changed
+
+	// Drag and drop events
+	EventDropFile     EventType = 0x1000 // the system requests a file open
+	EventDropText     EventType = 0x1001 // text/plain drag-and-drop event
+	EventDropBegin    EventType = 0x1002 // a new set of drops is beginning
+	EventDropComplete EventType = 0x1003 // current set of drops is now complete
+
+	// Events reserved for the application
+	EventUser EventType = 0x8000
+	EventLast EventType = 0xFFFF
+)
+
+// Event is the union of all event structures; the specific structure can be
+// obtained with the corresponding method, after checking the Type field.
+type Event struct {
+	Type      EventType
+	Timestamp uint32
+	_         [6]uint64 // padding to the size of the C union
+}
+
+// CommonEvent holds the fields shared by all events.
+type CommonEvent struct {
+	Type      EventType
+	Timestamp uint32
+}
+
+// WindowEvent is the structure of window state change events.
+type WindowEvent struct {
+	Type      EventType
+	Timestamp uint32
+	WindowID  uint32 // the associated window
+	Event     WindowEventID
+	_         [3]uint8
+	Data1     int32 // event dependent data
+	Data2     int32 // event dependent data
+}
+
+// Window returns the window event structure.
+func (e *Event) Window() *WindowEvent {
+	return (*WindowEvent)(unsafe.Pointer(e))
+}
+
+// DropEvent is the structure of drag and drop events.
+type DropEvent struct {
+	Type      EventType
+	Timestamp uint32
+	File      uintptr // file name or text, to be released with TakeDropFile
+	WindowID  uint32  // the window that was dropped on, if any
+}
+
+// Drop returns the drop event structure.
+func (e *Event) Drop() *DropEvent {
+	return (*DropEvent)(unsafe.Pointer(e))
+}
+
+// Arguments of EventState.
+const (
+	Query   int32 = -1
+	Ignore  int32 = 0
+	Disable int32 = 0
+	Enable  int32 = 1
+)
+
+// The kind of window state change.
+type WindowEventID uint8
+
+const (
+	WindowEventNone        WindowEventID = iota // never used
+	WindowEventShown                            // window has been shown
+	WindowEventHidden                           // window has been hidden
+	WindowEventExposed                          // window has been exposed and should be redrawn
+	WindowEventMoved                            // window has been moved to Data1, Data2
+	WindowEventResized                          // window has been resized to Data1, Data2 (external event)
+	WindowEventSizeChanged                      // window size has changed, either by the API or externally
+	WindowEventMinimized                        // window has been minimized
+	WindowEventMaximized                        // window has been maximized
+	WindowEventRestored                         // window has been restored to normal size and position
+	WindowEventEnter                            // window has gained mouse focus
+	WindowEventLeave                            // window has lost mouse focus
+	WindowEventFocusGained                      // window has gained keyboard focus
+	WindowEventFocusLost                        // window has lost keyboard focus
+	WindowEventClose                            // the window manager requests that the window be closed
+	WindowEventTakeFocus                        // window is being offered a focus
+	WindowEventHitTest                          // window had a hit test that wasn't normal
+)
@@ -0,0 +1,35 @@
+package sdl
+
+import "unsafe"
+
+var (
+	SDL_PollEvent  = dll.NewProc("SDL_PollEvent")
+	SDL_PumpEvents = dll.NewProc("SDL_PumpEvents")
+	SDL_EventState = dll.NewProc("SDL_EventState")
+)
+
+func PollEvent(e *Event) bool {
+	r, _, _ := SDL_PollEvent.Call(uintptr(unsafe.Pointer(e)))
+	return r != 0
+}
+
+func PumpEvents() {
+	SDL_PumpEvents.Call()
+}
+
+func EventState(t EventType, state int32) uint8 {
+	r, _, _ := SDL_EventState.Call(uintptr(t), uintptr(state))
+	return uint8(r)
+}
+
+// TakeDropFile returns the file name or text of a drop event, and frees the
+// string allocated by SDL.
+func TakeDropFile(e *DropEvent) string {
+	if e.File == 0 {
+		return ""
+	}
+	s := goString(e.File)
+	SDL_free.Call(e.File)
+	e.File = 0
+	return s
+}
@@ -0,0 +1,63 @@
+// +build !windows
+
+package sdl
+
+//#include "sdl.h"
+import "C"
+
+import "unsafe"
+
+func GameControllerOpen(index int32) (GameController, error) {
+	c := C.SDL_GameControllerOpen(C.int(index))
+	if c == nil {
+		return GameController{0}, GetError()
+	}
+	return GameController{uintptr(unsafe.Pointer(c))}, nil
+}
+
+func GameControllerClose(c GameController) {
+	C.SDL_GameControllerClose((*C.SDL_GameController)(unsafe.Pointer(c.uintptr)))
+}
+
+func GameControllerName(c GameController) string {
+	n := C.SDL_GameControllerName((*C.SDL_GameController)(unsafe.Pointer(c.uintptr)))
+	if n == nil {
+		return ""
+	}
+	return C.GoString(n)
+}
+
+func GameControllerGetJoystick(c GameController) Joystick {
+	j := C.SDL_GameControllerGetJoystick((*C.SDL_GameController)(unsafe.Pointer(c.uintptr)))
+	return Joystick{uintptr(unsafe.Pointer(j))}
+}
+
+func JoystickInstanceID(j Joystick) int32 {
+	return int32(C.SDL_JoystickInstanceID((*C.SDL_Joystick)(unsafe.Pointer(j.uintptr))))
+}
+
+func GameControllerGetButton(c GameController, b int32) uint8 {
+	return uint8(C.SDL_GameControllerGetButton((*C.SDL_GameController)(unsafe.Pointer(c.uintptr)), C.SDL_GameControllerButton(b)))
+}
+
+func GameControllerGetAxis(c GameController, a int32) int16 {
+	return int16(C.SDL_GameControllerGetAxis((*C.SDL_GameController)(unsafe.Pointer(c.uintptr)), C.SDL_GameControllerAxis(a)))
+}
+
+func GameControllerAddMapping(mapping string) (bool, error) {
+	m := C.CString(mapping)
+	defer C.free(unsafe.Pointer(m))
+	r := C.SDL_GameControllerAddMapping(m)
+	if r < 0 {
+		return false, GetError()
+	}
+	return r == 1, nil
+}
+
+func GameControllerRumble(c GameController, low, high uint16, ms uint32) error {
+	errc := C.SDL_GameControllerRumble((*C.SDL_GameController)(unsafe.Pointer(c.uintptr)), C.Uint16(low), C.Uint16(high), C.Uint32(ms))
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
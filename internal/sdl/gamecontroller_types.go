@@ -0,0 +1,86 @@
+package sdl
+
+import "unsafe"
+
+// An opaque handle to an opened game controller.
+type GameController pointer
+
+// An opaque handle to an opened joystick.
+type Joystick pointer
+
+// ControllerAxisEvent is the structure of game controller axis motion events.
+type ControllerAxisEvent struct {
+	Type      EventType
+	Timestamp uint32
+	Which     int32 // the joystick instance id
+	Axis      uint8 // the controller axis
+	_         [3]uint8
+	Value     int16 // the axis value (range: -32768 to 32767)
+	_         uint16
+}
+
+// CAxis returns the game controller axis event structure.
+func (e *Event) CAxis() *ControllerAxisEvent {
+	return (*ControllerAxisEvent)(unsafe.Pointer(e))
+}
+
+// ControllerButtonEvent is the structure of game controller button events.
+type ControllerButtonEvent struct {
+	Type      EventType
+	Timestamp uint32
+	Which     int32 // the joystick instance id
+	Button    uint8 // the controller button
+	State     uint8 // Pressed or Released
+	_         [2]uint8
+}
+
+// CButton returns the game controller button event structure.
+func (e *Event) CButton() *ControllerButtonEvent {
+	return (*ControllerButtonEvent)(unsafe.Pointer(e))
+}
+
+// ControllerDeviceEvent is the structure of game controller device events.
+type ControllerDeviceEvent struct {
+	Type      EventType
+	Timestamp uint32
+	Which     int32 // the joystick device index for added events, instance id otherwise
+}
+
+// CDevice returns the game controller device event structure.
+func (e *Event) CDevice() *ControllerDeviceEvent {
+	return (*ControllerDeviceEvent)(unsafe.Pointer(e))
+}
+
+const (
+	ControllerButtonInvalid int32 = iota - 1
+	ControllerButtonA
+	ControllerButtonB
+	ControllerButtonX
+	ControllerButtonY
+	ControllerButtonBack
+	ControllerButtonGuide
+	ControllerButtonStart
+	ControllerButtonLeftStick
+	ControllerButtonRightStick
+	ControllerButtonLeftShoulder
+	ControllerButtonRightShoulder
+	ControllerButtonDPadUp
+	ControllerButtonDPadDown
+	ControllerButtonDPadLeft
+	ControllerButtonDPadRight
+	ControllerButtonMax
+)
+
+const (
+	ControllerAxisInvalid int32 = iota - 1
+	ControllerAxisLeftX
+	ControllerAxisLeftY
+	ControllerAxisRightX
+	ControllerAxisRightY
+	ControllerAxisTriggerLeft
+	ControllerAxisTriggerRight
+	ControllerAxisMax
+)
+
+// An opaque handle to an opened haptic device.
+type Haptic pointer
@@ -0,0 +1,69 @@
+package sdl
+
+var (
+	SDL_GameControllerOpen        = dll.NewProc("SDL_GameControllerOpen")
+	SDL_GameControllerClose       = dll.NewProc("SDL_GameControllerClose")
+	SDL_GameControllerName        = dll.NewProc("SDL_GameControllerName")
+	SDL_GameControllerGetJoystick = dll.NewProc("SDL_GameControllerGetJoystick")
+	SDL_JoystickInstanceID        = dll.NewProc("SDL_JoystickInstanceID")
+	SDL_GameControllerGetButton   = dll.NewProc("SDL_GameControllerGetButton")
+	SDL_GameControllerGetAxis     = dll.NewProc("SDL_GameControllerGetAxis")
+	SDL_GameControllerAddMapping  = dll.NewProc("SDL_GameControllerAddMapping")
+	SDL_GameControllerRumble      = dll.NewProc("SDL_GameControllerRumble")
+)
+
+func GameControllerOpen(index int32) (GameController, error) {
+	c, _, _ := SDL_GameControllerOpen.Call(uintptr(index))
+	if c == 0 {
+		return GameController{0}, GetError()
+	}
+	return GameController{c}, nil
+}
+
+func GameControllerClose(c GameController) {
+	SDL_GameControllerClose.Call(c.uintptr)
+}
+
+func GameControllerName(c GameController) string {
+	n, _, _ := SDL_GameControllerName.Call(c.uintptr)
+	if n == 0 {
+		return ""
+	}
+	return goString(n)
+}
+
+func GameControllerGetJoystick(c GameController) Joystick {
+	j, _, _ := SDL_GameControllerGetJoystick.Call(c.uintptr)
+	return Joystick{j}
+}
+
+func JoystickInstanceID(j Joystick) int32 {
+	id, _, _ := SDL_JoystickInstanceID.Call(j.uintptr)
+	return int32(id)
+}
+
+func GameControllerGetButton(c GameController, b int32) uint8 {
+	r, _, _ := SDL_GameControllerGetButton.Call(c.uintptr, uintptr(b))
+	return uint8(r)
+}
+
+func GameControllerGetAxis(c GameController, a int32) int16 {
+	r, _, _ := SDL_GameControllerGetAxis.Call(c.uintptr, uintptr(a))
+	return int16(r)
+}
+
+func GameControllerAddMapping(mapping string) (bool, error) {
+	r, _, _ := SDL_GameControllerAddMapping.Call(cString(mapping))
+	if int32(r) < 0 {
+		return false, GetError()
+	}
+	return int32(r) == 1, nil
+}
+
+func GameControllerRumble(c GameController, low, high uint16, ms uint32) error {
+	errc, _, _ := SDL_GameControllerRumble.Call(c.uintptr, uintptr(low), uintptr(high), uintptr(ms))
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
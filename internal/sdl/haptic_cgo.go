@@ -0,0 +1,44 @@
+// +build !windows
+
+package sdl
+
+//#include "sdl.h"
+import "C"
+
+import "unsafe"
+
+func HapticOpenFromJoystick(j Joystick) (Haptic, error) {
+	h := C.SDL_HapticOpenFromJoystick((*C.SDL_Joystick)(unsafe.Pointer(j.uintptr)))
+	if h == nil {
+		return Haptic{0}, GetError()
+	}
+	return Haptic{uintptr(unsafe.Pointer(h))}, nil
+}
+
+func HapticClose(h Haptic) {
+	C.SDL_HapticClose((*C.SDL_Haptic)(unsafe.Pointer(h.uintptr)))
+}
+
+func HapticRumbleInit(h Haptic) error {
+	errc := C.SDL_HapticRumbleInit((*C.SDL_Haptic)(unsafe.Pointer(h.uintptr)))
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func HapticRumblePlay(h Haptic, strength float32, ms uint32) error {
+	errc := C.SDL_HapticRumblePlay((*C.SDL_Haptic)(unsafe.Pointer(h.uintptr)), C.float(strength), C.Uint32(ms))
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func HapticRumbleStop(h Haptic) error {
+	errc := C.SDL_HapticRumbleStop((*C.SDL_Haptic)(unsafe.Pointer(h.uintptr)))
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
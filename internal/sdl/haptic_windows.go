@@ -0,0 +1,49 @@
+package sdl
+
+import "math"
+
+var (
+	SDL_HapticOpenFromJoystick = dll.NewProc("SDL_HapticOpenFromJoystick")
+	SDL_HapticClose            = dll.NewProc("SDL_HapticClose")
+	SDL_HapticRumbleInit       = dll.NewProc("SDL_HapticRumbleInit")
+	SDL_HapticRumblePlay       = dll.NewProc("SDL_HapticRumblePlay")
+	SDL_HapticRumbleStop       = dll.NewProc("SDL_HapticRumbleStop")
+)
+
+func HapticOpenFromJoystick(j Joystick) (Haptic, error) {
+	h, _, _ := SDL_HapticOpenFromJoystick.Call(j.uintptr)
+	if h == 0 {
+		return Haptic{0}, GetError()
+	}
+	return Haptic{h}, nil
+}
+
+func HapticClose(h Haptic) {
+	SDL_HapticClose.Call(h.uintptr)
+}
+
+func HapticRumbleInit(h Haptic) error {
+	errc, _, _ := SDL_HapticRumbleInit.Call(h.uintptr)
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func HapticRumblePlay(h Haptic, strength float32, ms uint32) error {
+	// The float is passed in the XMM register matching its position, which
+	// the syscall package also loads from the argument.
+	errc, _, _ := SDL_HapticRumblePlay.Call(h.uintptr, uintptr(math.Float32bits(strength)), uintptr(ms))
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func HapticRumbleStop(h Haptic) error {
+	errc, _, _ := SDL_HapticRumbleStop.Call(h.uintptr)
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+// +build !windows
+
+package sdl
+
+//#include "sdl.h"
+import "C"
+
+import "unsafe"
+
+func GetKeyboardState() []uint8 {
+	var n C.int
+	s := C.SDL_GetKeyboardState(&n)
+	return (*[NumScancodes]uint8)(unsafe.Pointer(s))[:n:n]
+}
+
+func GetModState() uint16 {
+	return uint16(C.SDL_GetModState())
+}
+
+func StartTextInput() {
+	C.SDL_StartTextInput()
+}
+
+func StopTextInput() {
+	C.SDL_StopTextInput()
+}
+
+func IsTextInputActive() bool {
+	return C.SDL_IsTextInputActive() == C.SDL_TRUE
+}
+
+func SetTextInputRect(r *Rect) {
+	C.SDL_SetTextInputRect((*C.SDL_Rect)(unsafe.Pointer(r)))
+}
@@ -0,0 +1,64 @@
+package sdl
+
+import "unsafe"
+
+// Keysym describes a key: its physical position (Scancode), its meaning in the
+// current layout (Sym), and the active modifiers.
+type Keysym struct {
+	Scancode int32  // SDL physical key code
+	Sym      int32  // SDL virtual key code
+	Mod      uint16 // current key modifiers
+	_        uint32
+}
+
+// KeyboardEvent is the structure of key down and key up events.
+type KeyboardEvent struct {
+	Type      EventType
+	Timestamp uint32
+	WindowID  uint32 // the window with keyboard focus, if any
+	State     uint8  // Pressed or Released
+	Repeat    uint8  // non-zero if this is a key repeat
+	_         [2]uint8
+	Keysym    Keysym // the key that was pressed or released
+}
+
+// Key returns the keyboard event structure.
+func (e *Event) Key() *KeyboardEvent {
+	return (*KeyboardEvent)(unsafe.Pointer(e))
+}
+
+// TextEditingEvent is the structure of text composition events.
+type TextEditingEvent struct {
+	Type      EventType
+	Timestamp uint32
+	WindowID  uint32    // the window with keyboard focus, if any
+	Text      [32]uint8 // the composition text, null-terminated UTF-8
+	Start     int32     // position of the cursor, in characters
+	Length    int32     // length of the selection, in characters
+}
+
+// Edit returns the text editing event structure.
+func (e *Event) Edit() *TextEditingEvent {
+	return (*TextEditingEvent)(unsafe.Pointer(e))
+}
+
+// TextInputEvent is the structure of text input events.
+type TextInputEvent struct {
+	Type      EventType
+	Timestamp uint32
+	WindowID  uint32    // the window with keyboard focus, if any
+	Text      [32]uint8 // the input text, null-terminated UTF-8
+}
+
+// Text returns the text input event structure.
+func (e *Event) Text() *TextInputEvent {
+	return (*TextInputEvent)(unsafe.Pointer(e))
+}
+
+const (
+	Released uint8 = 0
+	Pressed  uint8 = 1
+)
+
+// NumScancodes is the size of the keyboard state array.
+const NumScancodes = 512
@@ -0,0 +1,40 @@
+package sdl
+
+import "unsafe"
+
+var (
+	SDL_GetKeyboardState  = dll.NewProc("SDL_GetKeyboardState")
+	SDL_GetModState       = dll.NewProc("SDL_GetModState")
+	SDL_StartTextInput    = dll.NewProc("SDL_StartTextInput")
+	SDL_StopTextInput     = dll.NewProc("SDL_StopTextInput")
+	SDL_IsTextInputActive = dll.NewProc("SDL_IsTextInputActive")
+	SDL_SetTextInputRect  = dll.NewProc("SDL_SetTextInputRect")
+)
+
+func GetKeyboardState() []uint8 {
+	var n int32
+	s, _, _ := SDL_GetKeyboardState.Call(uintptr(unsafe.Pointer(&n)))
+	return (*[NumScancodes]uint8)(unsafe.Pointer(s))[:n:n]
+}
+
+func GetModState() uint16 {
+	m, _, _ := SDL_GetModState.Call()
+	return uint16(m)
+}
+
+func StartTextInput() {
+	SDL_StartTextInput.Call()
+}
+
+func StopTextInput() {
+	SDL_StopTextInput.Call()
+}
+
+func IsTextInputActive() bool {
+	r, _, _ := SDL_IsTextInputActive.Call()
+	return r&0xFF != 0
+}
+
+func SetTextInputRect(r *Rect) {
+	SDL_SetTextInputRect.Call(uintptr(unsafe.Pointer(r)))
+}
@@ -0,0 +1,98 @@
+// +build !windows
+
+package sdl
+
+//#include "sdl.h"
+import "C"
+
+import "unsafe"
+
+func GetMouseState(x, y *int32) uint32 {
+	return uint32(C.SDL_GetMouseState((*C.int)(x), (*C.int)(y)))
+}
+
+func WarpMouseInWindow(w Window, x, y int32) {
+	C.SDL_WarpMouseInWindow((*C.SDL_Window)(unsafe.Pointer(w.uintptr)), C.int(x), C.int(y))
+}
+
+func SetRelativeMouseMode(enabled bool) error {
+	e := C.SDL_bool(C.SDL_FALSE)
+	if enabled {
+		e = C.SDL_TRUE
+	}
+	errc := C.SDL_SetRelativeMouseMode(e)
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func GetRelativeMouseMode() bool {
+	return C.SDL_GetRelativeMouseMode() == C.SDL_TRUE
+}
+
+func CaptureMouse(enabled bool) error {
+	e := C.SDL_bool(C.SDL_FALSE)
+	if enabled {
+		e = C.SDL_TRUE
+	}
+	errc := C.SDL_CaptureMouse(e)
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func GetGlobalMouseState(x, y *int32) uint32 {
+	return uint32(C.SDL_GetGlobalMouseState((*C.int)(x), (*C.int)(y)))
+}
+
+// CreateColorCursor creates a cursor from an image of w by h pixels, stored
+// in pix in RGBA byte order (non-premultiplied), with the given stride.
+func CreateColorCursor(pix []byte, w, h, stride int32, hotX, hotY int32) (Cursor, error) {
+	// SDL keeps a pointer to the pixels in the surface
+	p := C.CBytes(pix)
+	defer C.free(p)
+
+	rm, gm, bm, am := rgbaMasks()
+	s := C.SDL_CreateRGBSurfaceFrom(p, C.int(w), C.int(h), 32, C.int(stride),
+		C.Uint32(rm), C.Uint32(gm), C.Uint32(bm), C.Uint32(am))
+	if s == nil {
+		return Cursor{0}, GetError()
+	}
+	defer C.SDL_FreeSurface(s)
+
+	c := C.SDL_CreateColorCursor(s, C.int(hotX), C.int(hotY))
+	if c == nil {
+		return Cursor{0}, GetError()
+	}
+	return Cursor{uintptr(unsafe.Pointer(c))}, nil
+}
+
+func CreateSystemCursor(id SystemCursor) (Cursor, error) {
+	c := C.SDL_CreateSystemCursor(C.SDL_SystemCursor(id))
+	if c == nil {
+		return Cursor{0}, GetError()
+	}
+	return Cursor{uintptr(unsafe.Pointer(c))}, nil
+}
+
+func SetCursor(c Cursor) {
+	C.SDL_SetCursor((*C.SDL_Cursor)(unsafe.Pointer(c.uintptr)))
+}
+
+func GetDefaultCursor() Cursor {
+	return Cursor{uintptr(unsafe.Pointer(C.SDL_GetDefaultCursor()))}
+}
+
+func FreeCursor(c Cursor) {
+	C.SDL_FreeCursor((*C.SDL_Cursor)(unsafe.Pointer(c.uintptr)))
+}
+
+func ShowCursor(toggle int32) (int32, error) {
+	r := C.SDL_ShowCursor(C.int(toggle))
+	if r < 0 {
+		return 0, GetError()
+	}
+	return int32(r), nil
+}
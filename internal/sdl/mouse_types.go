@@ -0,0 +1,112 @@
+package sdl
+
+import "unsafe"
+
+// MouseMotionEvent is the structure of mouse motion events.
+type MouseMotionEvent struct {
+	Type      EventType
+	Timestamp uint32
+	WindowID  uint32 // the window with mouse focus, if any
+	Which     uint32 // the mouse instance id, or TouchMouseID
+	State     uint32 // the current button state
+	X         int32  // relative to window
+	Y         int32  // relative to window
+	XRel      int32  // relative motion in the X direction
+	YRel      int32  // relative motion in the Y direction
+}
+
+// Motion returns the mouse motion event structure.
+func (e *Event) Motion() *MouseMotionEvent {
+	return (*MouseMotionEvent)(unsafe.Pointer(e))
+}
+
+// MouseButtonEvent is the structure of mouse button events.
+type MouseButtonEvent struct {
+	Type      EventType
+	Timestamp uint32
+	WindowID  uint32 // the window with mouse focus, if any
+	Which     uint32 // the mouse instance id, or TouchMouseID
+	Button    uint8  // the mouse button index
+	State     uint8  // Pressed or Released
+	Clicks    uint8  // 1 for single-click, 2 for double-click, etc.
+	_         uint8
+	X         int32 // relative to window
+	Y         int32 // relative to window
+}
+
+// Button returns the mouse button event structure.
+func (e *Event) Button() *MouseButtonEvent {
+	return (*MouseButtonEvent)(unsafe.Pointer(e))
+}
+
+// MouseWheelEvent is the structure of mouse wheel events. The precise fields
+// are only filled since SDL 2.0.18.
+type MouseWheelEvent struct {
+	Type      EventType
+	Timestamp uint32
+	WindowID  uint32 // the window with mouse focus, if any
+	Which     uint32 // the mouse instance id, or TouchMouseID
+	X         int32  // amount scrolled horizontally, positive to the right
+	Y         int32  // amount scrolled vertically, positive away from the user
+	Direction uint32 // MouseWheelNormal or MouseWheelFlipped
+	PreciseX  float32
+	PreciseY  float32
+}
+
+// Wheel returns the mouse wheel event structure.
+func (e *Event) Wheel() *MouseWheelEvent {
+	return (*MouseWheelEvent)(unsafe.Pointer(e))
+}
+
+// TouchMouseID is used as mouse instance id for events generated by touch
+// input devices.
+const TouchMouseID uint32 = 0xFFFFFFFF
+
+const (
+	MouseWheelNormal  uint32 = 0
+	MouseWheelFlipped uint32 = 1
+)
+
+const (
+	ButtonLeft   uint8 = 1
+	ButtonMiddle uint8 = 2
+	ButtonRight  uint8 = 3
+	ButtonX1     uint8 = 4
+	ButtonX2     uint8 = 5
+)
+
+// ButtonMask returns the mask of button b in the button state.
+func ButtonMask(b uint8) uint32 {
+	return 1 << (b - 1)
+}
+
+// Cursor is an opaque handle to an SDL cursor.
+type Cursor pointer
+
+// The standard system cursors.
+type SystemCursor int32
+
+const (
+	SystemCursorArrow     SystemCursor = iota // arrow
+	SystemCursorIBeam                         // I-beam
+	SystemCursorWait                          // wait
+	SystemCursorCrosshair                     // crosshair
+	SystemCursorWaitArrow                     // small wait cursor (or wait if not available)
+	SystemCursorSizeNWSE                      // double arrow pointing northwest and southeast
+	SystemCursorSizeNESW                      // double arrow pointing northeast and southwest
+	SystemCursorSizeWE                        // double arrow pointing west and east
+	SystemCursorSizeNS                        // double arrow pointing north and south
+	SystemCursorSizeAll                       // four pointed arrow pointing north, south, east, and west
+	SystemCursorNo                            // slashed circle or crossbones
+	SystemCursorHand                          // hand
+)
+
+// rgbaMasks returns the masks of a 32-bit surface whose pixels are stored in
+// RGBA byte order.
+func rgbaMasks() (r, g, b, a uint32) {
+	x := uint16(1)
+	if *(*byte)(unsafe.Pointer(&x)) == 1 {
+		return 0x000000FF, 0x0000FF00, 0x00FF0000, 0xFF000000
+	}
+	return 0xFF000000, 0x00FF0000, 0x0000FF00, 0x000000FF
+}
@@ -0,0 +1,106 @@
+package sdl
+
+import (
+	"runtime"
+	"unsafe"
+)
+
+var (
+	SDL_GetMouseState        = dll.NewProc("SDL_GetMouseState")
+	SDL_WarpMouseInWindow    = dll.NewProc("SDL_WarpMouseInWindow")
+	SDL_SetRelativeMouseMode = dll.NewProc("SDL_SetRelativeMouseMode")
+	SDL_GetRelativeMouseMode = dll.NewProc("SDL_GetRelativeMouseMode")
+	SDL_CaptureMouse         = dll.NewProc("SDL_CaptureMouse")
+	SDL_GetGlobalMouseState  = dll.NewProc("SDL_GetGlobalMouseState")
+	SDL_CreateRGBSurfaceFrom = dll.NewProc("SDL_CreateRGBSurfaceFrom")
+	SDL_FreeSurface          = dll.NewProc("SDL_FreeSurface")
+	SDL_CreateColorCursor    = dll.NewProc("SDL_CreateColorCursor")
+	SDL_CreateSystemCursor   = dll.NewProc("SDL_CreateSystemCursor")
+	SDL_SetCursor            = dll.NewProc("SDL_SetCursor")
+	SDL_GetDefaultCursor     = dll.NewProc("SDL_GetDefaultCursor")
+	SDL_FreeCursor           = dll.NewProc("SDL_FreeCursor")
+	SDL_ShowCursor           = dll.NewProc("SDL_ShowCursor")
+)
+
+func GetMouseState(x, y *int32) uint32 {
+	s, _, _ := SDL_GetMouseState.Call(uintptr(unsafe.Pointer(x)), uintptr(unsafe.Pointer(y)))
+	return uint32(s)
+}
+
+func WarpMouseInWindow(w Window, x, y int32) {
+	SDL_WarpMouseInWindow.Call(w.uintptr, uintptr(x), uintptr(y))
+}
+
+func SetRelativeMouseMode(enabled bool) error {
+	errc, _, _ := SDL_SetRelativeMouseMode.Call(boolArg(enabled))
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func GetRelativeMouseMode() bool {
+	r, _, _ := SDL_GetRelativeMouseMode.Call()
+	return r&0xFF != 0
+}
+
+func CaptureMouse(enabled bool) error {
+	errc, _, _ := SDL_CaptureMouse.Call(boolArg(enabled))
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func GetGlobalMouseState(x, y *int32) uint32 {
+	s, _, _ := SDL_GetGlobalMouseState.Call(uintptr(unsafe.Pointer(x)), uintptr(unsafe.Pointer(y)))
+	return uint32(s)
+}
+
+// CreateColorCursor creates a cursor from an image of w by h pixels, stored
+// in pix in RGBA byte order (non-premultiplied), with the given stride.
+func CreateColorCursor(pix []byte, w, h, stride int32, hotX, hotY int32) (Cursor, error) {
+	rm, gm, bm, am := rgbaMasks()
+	s, _, _ := SDL_CreateRGBSurfaceFrom.Call(uintptr(unsafe.Pointer(&pix[0])), uintptr(w), uintptr(h), 32, uintptr(stride),
+		uintptr(rm), uintptr(gm), uintptr(bm), uintptr(am))
+	if s == 0 {
+		return Cursor{0}, GetError()
+	}
+	defer SDL_FreeSurface.Call(s)
+
+	c, _, _ := SDL_CreateColorCursor.Call(s, uintptr(hotX), uintptr(hotY))
+	runtime.KeepAlive(pix)
+	if c == 0 {
+		return Cursor{0}, GetError()
+	}
+	return Cursor{c}, nil
+}
+
+func CreateSystemCursor(id SystemCursor) (Cursor, error) {
+	c, _, _ := SDL_CreateSystemCursor.Call(uintptr(id))
+	if c == 0 {
+		return Cursor{0}, GetError()
+	}
+	return Cursor{c}, nil
+}
+
+func SetCursor(c Cursor) {
+	SDL_SetCursor.Call(c.uintptr)
+}
+
+func GetDefaultCursor() Cursor {
+	c, _, _ := SDL_GetDefaultCursor.Call()
+	return Cursor{c}
+}
+
+func FreeCursor(c Cursor) {
+	SDL_FreeCursor.Call(c.uintptr)
+}
+
+func ShowCursor(toggle int32) (int32, error) {
+	r, _, _ := SDL_ShowCursor.Call(uintptr(toggle))
+	if int32(r) < 0 {
+		return 0, GetError()
+	}
+	return int32(r), nil
+}
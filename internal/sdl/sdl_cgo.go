@@ -10,6 +10,11 @@ package sdl
 */
 import "C"
 
+import (
+	"errors"
+	"unsafe"
+)
+
 func Init(f InitFlags) error {
 	errc := C.SDL_Init(C.Uint32(f))
 	if errc != 0 {
@@ -37,3 +42,78 @@ func WasInit(f InitFlags) InitFlags {
 func Quit() {
 	C.SDL_Quit()
 }
+
+func GLLoadDefaultLibrary() error {
+	errc := C.SDL_GL_LoadLibrary(nil)
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func GLLoadLibrary(name string) error {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	errc := C.SDL_GL_LoadLibrary(cname)
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func GLGetProcAddress(name string) (error, uintptr) {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	addr := uintptr(C.SDL_GL_GetProcAddress(cname))
+	if addr == 0 {
+		return errors.New("sdl.GLGetProcAddress: unable to find address for " + name), 0
+	}
+	return nil, addr
+}
+
+func GLUnloadLibrary() {
+	C.SDL_GL_UnloadLibrary()
+}
+
+func GetPlatform() string {
+	return C.GoString(C.SDL_GetPlatform())
+}
+
+func SetHint(name, value string) bool {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	cvalue := C.CString(value)
+	defer C.free(unsafe.Pointer(cvalue))
+	return C.SDL_SetHint(cname, cvalue) != 0
+}
+
+func SetHintWithPriority(name, value string, p HintPriority) bool {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	cvalue := C.CString(value)
+	defer C.free(unsafe.Pointer(cvalue))
+	return C.SDL_SetHintWithPriority(cname, cvalue, C.SDL_HintPriority(p)) != 0
+}
+
+func GetCurrentVideoDriver() string {
+	return C.GoString(C.SDL_GetCurrentVideoDriver())
+}
+
+func ThreadID() uint64 {
+	return uint64(C.SDL_ThreadID())
+}
+
+func GetPerformanceCounter() uint64 {
+	return uint64(C.SDL_GetPerformanceCounter())
+}
+
+func GetPerformanceFrequency() uint64 {
+	return uint64(C.SDL_GetPerformanceFrequency())
+}
+
+// GetVersion returns the version of the SDL library linked at run time.
+func GetVersion() Version {
+	var v C.SDL_version
+	C.SDL_GetVersion(&v)
+	return Version{uint8(v.major), uint8(v.minor), uint8(v.patch)}
+}
@@ -13,3 +13,33 @@ const (
 	InitSensor                   = 0x00008000
 	InitEverything               = InitTimer | InitAudio | InitVideo | InitEvents | InitJoystick | InitHaptic | InitGamecontroller | InitSensor
 )
+
+// Hints used by the platform packages.
+const (
+	HintVideoDriver = "SDL_VIDEODRIVER"
+)
+
+// HintPriority is the priority of a hint set with SetHintWithPriority.
+type HintPriority int32
+
+const (
+	HintDefault  HintPriority = 0
+	HintNormal   HintPriority = 1
+	HintOverride HintPriority = 2 // overrides the environment variables
+)
+
+// Version is the version of the SDL library.
+type Version struct {
+	Major, Minor, Patch uint8
+}
+
+// AtLeast returns true if v is the version major.minor.patch or a later one.
+func (v Version) AtLeast(major, minor, patch uint8) bool {
+	if v.Major != major {
+		return v.Major > major
+	}
+	if v.Minor != minor {
+		return v.Minor > minor
+	}
+	return v.Patch >= patch
+}
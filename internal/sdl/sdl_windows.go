@@ -10,14 +10,21 @@ import (
 var dll = windows.NewLazyDLL("SDL2.dll")
 
 var (
-	SDL_Init              = dll.NewProc("SDL_Init")
-	SDL_InitSubSystem     = dll.NewProc("SDL_InitSubSystem")
-	SDL_QuitSubSystem     = dll.NewProc("SDL_QuitSubSystem")
-	SDL_WasInit           = dll.NewProc("SDL_WasInit")
-	SDL_Quit              = dll.NewProc("SDL_Quit")
-	SDL_GL_LoadLibrary    = dll.NewProc("SDL_GL_LoadLibrary")
-	SDL_GL_GetProcAddress = dll.NewProc("SDL_GL_GetProcAddress")
-	SDL_GL_UnloadLibrary  = dll.NewProc("SDL_GL_UnloadLibrary")
+	SDL_Init                    = dll.NewProc("SDL_Init")
+	SDL_InitSubSystem           = dll.NewProc("SDL_InitSubSystem")
+	SDL_QuitSubSystem           = dll.NewProc("SDL_QuitSubSystem")
+	SDL_WasInit                 = dll.NewProc("SDL_WasInit")
+	SDL_Quit                    = dll.NewProc("SDL_Quit")
+	SDL_GL_LoadLibrary          = dll.NewProc("SDL_GL_LoadLibrary")
+	SDL_GL_GetProcAddress       = dll.NewProc("SDL_GL_GetProcAddress")
+	SDL_GL_UnloadLibrary        = dll.NewProc("SDL_GL_UnloadLibrary")
+	SDL_GetPlatform             = dll.NewProc("SDL_GetPlatform")
+	SDL_SetHint                 = dll.NewProc("SDL_SetHint")
+	SDL_GetCurrentVideoDriver   = dll.NewProc("SDL_GetCurrentVideoDriver")
+	SDL_GetPerformanceCounter   = dll.NewProc("SDL_GetPerformanceCounter")
+	SDL_GetPerformanceFrequency = dll.NewProc("SDL_GetPerformanceFrequency")
+	SDL_GetVersion              = dll.NewProc("SDL_GetVersion")
+	SDL_SetHintWithPriority     = dll.NewProc("SDL_SetHintWithPriority")
 )
 
 func Init(f InitFlags) error {
@@ -80,3 +87,50 @@ func GLGetProcAddress(name string) (error, uintptr) {
 	}
 	return nil, addr
 }
+
+func GLUnloadLibrary() {
+	SDL_GL_UnloadLibrary.Call()
+}
+
+func GetPlatform() string {
+	p, _, _ := SDL_GetPlatform.Call()
+	return goString(p)
+}
+
+func SetHint(name, value string) bool {
+	r, _, _ := SDL_SetHint.Call(cString(name), cString(value))
+	return r != 0
+}
+
+func SetHintWithPriority(name, value string, p HintPriority) bool {
+	r, _, _ := SDL_SetHintWithPriority.Call(cString(name), cString(value), uintptr(p))
+	return r != 0
+}
+
+func GetCurrentVideoDriver() string {
+	d, _, _ := SDL_GetCurrentVideoDriver.Call()
+	return goString(d)
+}
+
+// ThreadID is implemented without SDL (SDL_ThreadID is GetCurrentThreadId on
+// Windows), so that it can be called before the DLL is loaded.
+func ThreadID() uint64 {
+	return uint64(windows.GetCurrentThreadId())
+}
+
+func GetPerformanceCounter() uint64 {
+	c, _, _ := SDL_GetPerformanceCounter.Call()
+	return uint64(c)
+}
+
+func GetPerformanceFrequency() uint64 {
+	f, _, _ := SDL_GetPerformanceFrequency.Call()
+	return uint64(f)
+}
+
+// GetVersion returns the version of the SDL library loaded at run time.
+func GetVersion() Version {
+	var v Version
+	SDL_GetVersion.Call(uintptr(unsafe.Pointer(&v)))
+	return v
+}
@@ -10,7 +10,7 @@ import "unsafe"
 func GLSetAttribute(a GLAttr, value int32) error {
 	errc := C.SDL_GL_SetAttribute(C.SDL_GLattr(a), C.int(value))
 	if errc != 0 {
-		return Error("SDL: GL attribute could not be set")
+		return GetError()
 	}
 
 	return nil
@@ -59,6 +59,146 @@ func GLSwapWindow(w Window) {
 	C.SDL_GL_SwapWindow((*C.SDL_Window)(unsafe.Pointer(w.uintptr)))
 }
 
+func GLGetDrawableSize(w Window) (int32, int32) {
+	var x, y C.int
+	C.SDL_GL_GetDrawableSize((*C.SDL_Window)(unsafe.Pointer(w.uintptr)), &x, &y)
+	return int32(x), int32(y)
+}
+
 func DestroyWindow(w Window) {
 	C.SDL_DestroyWindow((*C.SDL_Window)(unsafe.Pointer(w.uintptr)))
 }
+
+func GetWindowID(w Window) uint32 {
+	return uint32(C.SDL_GetWindowID((*C.SDL_Window)(unsafe.Pointer(w.uintptr))))
+}
+
+func SetWindowTitle(w Window, title string) {
+	t := C.CString(title)
+	defer C.free(unsafe.Pointer(t))
+	C.SDL_SetWindowTitle((*C.SDL_Window)(unsafe.Pointer(w.uintptr)), t)
+}
+
+func SetWindowSize(w Window, width, height int32) {
+	C.SDL_SetWindowSize((*C.SDL_Window)(unsafe.Pointer(w.uintptr)), C.int(width), C.int(height))
+}
+
+func SetWindowPosition(w Window, x, y int32) {
+	C.SDL_SetWindowPosition((*C.SDL_Window)(unsafe.Pointer(w.uintptr)), C.int(x), C.int(y))
+}
+
+func GetWindowSize(w Window) (int32, int32) {
+	var x, y C.int
+	C.SDL_GetWindowSize((*C.SDL_Window)(unsafe.Pointer(w.uintptr)), &x, &y)
+	return int32(x), int32(y)
+}
+
+func GetWindowPosition(w Window) (int32, int32) {
+	var x, y C.int
+	C.SDL_GetWindowPosition((*C.SDL_Window)(unsafe.Pointer(w.uintptr)), &x, &y)
+	return int32(x), int32(y)
+}
+
+func SetWindowFullscreen(w Window, f WindowFlags) error {
+	errc := C.SDL_SetWindowFullscreen((*C.SDL_Window)(unsafe.Pointer(w.uintptr)), C.Uint32(f))
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func GLMakeCurrent(w Window, c GLContext) error {
+	errc := C.SDL_GL_MakeCurrent((*C.SDL_Window)(unsafe.Pointer(w.uintptr)), C.SDL_GLContext(unsafe.Pointer(c.uintptr)))
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func GLDeleteContext(c GLContext) {
+	C.SDL_GL_DeleteContext(C.SDL_GLContext(unsafe.Pointer(c.uintptr)))
+}
+
+func GetNumVideoDisplays() (int32, error) {
+	n := C.SDL_GetNumVideoDisplays()
+	if n < 0 {
+		return 0, GetError()
+	}
+	return int32(n), nil
+}
+
+func GetDisplayName(index int32) (string, error) {
+	n := C.SDL_GetDisplayName(C.int(index))
+	if n == nil {
+		return "", GetError()
+	}
+	return C.GoString(n), nil
+}
+
+func GetDisplayBounds(index int32, r *Rect) error {
+	errc := C.SDL_GetDisplayBounds(C.int(index), (*C.SDL_Rect)(unsafe.Pointer(r)))
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func GetDisplayUsableBounds(index int32, r *Rect) error {
+	errc := C.SDL_GetDisplayUsableBounds(C.int(index), (*C.SDL_Rect)(unsafe.Pointer(r)))
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func GetDisplayDPI(index int32, ddpi, hdpi, vdpi *float32) error {
+	errc := C.SDL_GetDisplayDPI(C.int(index), (*C.float)(ddpi), (*C.float)(hdpi), (*C.float)(vdpi))
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func GetNumDisplayModes(index int32) (int32, error) {
+	n := C.SDL_GetNumDisplayModes(C.int(index))
+	if n < 0 {
+		return 0, GetError()
+	}
+	return int32(n), nil
+}
+
+func GetDisplayMode(index, mode int32, m *DisplayMode) error {
+	errc := C.SDL_GetDisplayMode(C.int(index), C.int(mode), (*C.SDL_DisplayMode)(unsafe.Pointer(m)))
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func GetDesktopDisplayMode(index int32, m *DisplayMode) error {
+	errc := C.SDL_GetDesktopDisplayMode(C.int(index), (*C.SDL_DisplayMode)(unsafe.Pointer(m)))
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func GetWindowDisplayIndex(w Window) (int32, error) {
+	i := C.SDL_GetWindowDisplayIndex((*C.SDL_Window)(unsafe.Pointer(w.uintptr)))
+	if i < 0 {
+		return 0, GetError()
+	}
+	return int32(i), nil
+}
+
+func SetWindowDisplayMode(w Window, m *DisplayMode) error {
+	errc := C.SDL_SetWindowDisplayMode((*C.SDL_Window)(unsafe.Pointer(w.uintptr)), (*C.SDL_DisplayMode)(unsafe.Pointer(m)))
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func GetPixelFormatName(format uint32) string {
+	return C.GoString(C.SDL_GetPixelFormatName(C.Uint32(format)))
+}
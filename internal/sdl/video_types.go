@@ -9,6 +9,12 @@ type DisplayMode struct {
 	DriverData  pointer // driver-specific, initialize to 0
 }
 
+// A rectangle, with the origin at the upper left.
+type Rect struct {
+	X, Y int32
+	W, H int32
+}
+
 // The type used to identify a window
 type Window pointer
 
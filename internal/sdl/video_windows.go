@@ -3,20 +3,41 @@ package sdl
 import "unsafe"
 
 var (
-	SDL_GL_SetAttribute    = dll.NewProc("SDL_GL_SetAttribute")
-	SDL_GL_GetAttribute    = dll.NewProc("SDL_GL_GetAttribute")
-	SDL_GL_CreateContext   = dll.NewProc("SDL_GL_CreateContext")
-	SDL_GL_SetSwapInterval = dll.NewProc("SDL_GL_SetSwapInterval")
-	SDL_GL_GetSwapInterval = dll.NewProc("SDL_GL_GetSwapInterval")
-	SDL_GL_SwapWindow      = dll.NewProc("SDL_GL_SwapWindow")
-	SDL_CreateWindow       = dll.NewProc("SDL_CreateWindow")
-	SDL_DestroyWindow      = dll.NewProc("SDL_DestroyWindow")
+	SDL_GL_SetAttribute        = dll.NewProc("SDL_GL_SetAttribute")
+	SDL_GL_GetAttribute        = dll.NewProc("SDL_GL_GetAttribute")
+	SDL_GL_CreateContext       = dll.NewProc("SDL_GL_CreateContext")
+	SDL_GL_SetSwapInterval     = dll.NewProc("SDL_GL_SetSwapInterval")
+	SDL_GL_GetSwapInterval     = dll.NewProc("SDL_GL_GetSwapInterval")
+	SDL_GL_SwapWindow          = dll.NewProc("SDL_GL_SwapWindow")
+	SDL_CreateWindow           = dll.NewProc("SDL_CreateWindow")
+	SDL_DestroyWindow          = dll.NewProc("SDL_DestroyWindow")
+	SDL_GetWindowID            = dll.NewProc("SDL_GetWindowID")
+	SDL_SetWindowTitle         = dll.NewProc("SDL_SetWindowTitle")
+	SDL_SetWindowSize          = dll.NewProc("SDL_SetWindowSize")
+	SDL_SetWindowPosition      = dll.NewProc("SDL_SetWindowPosition")
+	SDL_SetWindowFullscreen    = dll.NewProc("SDL_SetWindowFullscreen")
+	SDL_GL_MakeCurrent         = dll.NewProc("SDL_GL_MakeCurrent")
+	SDL_GL_DeleteContext       = dll.NewProc("SDL_GL_DeleteContext")
+	SDL_GetNumVideoDisplays    = dll.NewProc("SDL_GetNumVideoDisplays")
+	SDL_GetDisplayName         = dll.NewProc("SDL_GetDisplayName")
+	SDL_GetDisplayBounds       = dll.NewProc("SDL_GetDisplayBounds")
+	SDL_GetDisplayUsableBounds = dll.NewProc("SDL_GetDisplayUsableBounds")
+	SDL_GetDisplayDPI          = dll.NewProc("SDL_GetDisplayDPI")
+	SDL_GetNumDisplayModes     = dll.NewProc("SDL_GetNumDisplayModes")
+	SDL_GetDisplayMode         = dll.NewProc("SDL_GetDisplayMode")
+	SDL_GetDesktopDisplayMode  = dll.NewProc("SDL_GetDesktopDisplayMode")
+	SDL_SetWindowDisplayMode   = dll.NewProc("SDL_SetWindowDisplayMode")
+	SDL_GetPixelFormatName     = dll.NewProc("SDL_GetPixelFormatName")
+	SDL_GL_GetDrawableSize     = dll.NewProc("SDL_GL_GetDrawableSize")
+	SDL_GetWindowDisplayIndex  = dll.NewProc("SDL_GetWindowDisplayIndex")
+	SDL_GetWindowPosition      = dll.NewProc("SDL_GetWindowPosition")
+	SDL_GetWindowSize          = dll.NewProc("SDL_GetWindowSize")
 )
 
 func GLSetAttribute(a GLAttr, value int32) error {
 	errc, _, _ := SDL_GL_SetAttribute.Call(uintptr(a), uintptr(value))
 	if errc != 0 {
-		return Error("SDL: GL attribute could not be set")
+		return GetError()
 	}
 	return nil
 }
@@ -64,6 +85,151 @@ func GLSwapWindow(w Window) {
 	SDL_GL_SwapWindow.Call(w.uintptr)
 }
 
+func GLGetDrawableSize(w Window) (int32, int32) {
+	var x, y int32
+	SDL_GL_GetDrawableSize.Call(w.uintptr, uintptr(unsafe.Pointer(&x)), uintptr(unsafe.Pointer(&y)))
+	return x, y
+}
+
 func DestroyWindow(w Window) {
 	SDL_DestroyWindow.Call(w.uintptr)
 }
+
+func GetWindowID(w Window) uint32 {
+	id, _, _ := SDL_GetWindowID.Call(w.uintptr)
+	return uint32(id)
+}
+
+func SetWindowTitle(w Window, title string) {
+	SDL_SetWindowTitle.Call(w.uintptr, cString(title))
+}
+
+func SetWindowSize(w Window, width, height int32) {
+	SDL_SetWindowSize.Call(w.uintptr, uintptr(width), uintptr(height))
+}
+
+func SetWindowPosition(w Window, x, y int32) {
+	SDL_SetWindowPosition.Call(w.uintptr, uintptr(x), uintptr(y))
+}
+
+func GetWindowSize(w Window) (int32, int32) {
+	var x, y int32
+	SDL_GetWindowSize.Call(w.uintptr, uintptr(unsafe.Pointer(&x)), uintptr(unsafe.Pointer(&y)))
+	return x, y
+}
+
+func GetWindowPosition(w Window) (int32, int32) {
+	var x, y int32
+	SDL_GetWindowPosition.Call(w.uintptr, uintptr(unsafe.Pointer(&x)), uintptr(unsafe.Pointer(&y)))
+	return x, y
+}
+
+func SetWindowFullscreen(w Window, f WindowFlags) error {
+	errc, _, _ := SDL_SetWindowFullscreen.Call(w.uintptr, uintptr(f))
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func GLMakeCurrent(w Window, c GLContext) error {
+	errc, _, _ := SDL_GL_MakeCurrent.Call(w.uintptr, c.uintptr)
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func GLDeleteContext(c GLContext) {
+	SDL_GL_DeleteContext.Call(c.uintptr)
+}
+
+func GetNumVideoDisplays() (int32, error) {
+	n, _, _ := SDL_GetNumVideoDisplays.Call()
+	if int32(n) < 0 {
+		return 0, GetError()
+	}
+	return int32(n), nil
+}
+
+func GetDisplayName(index int32) (string, error) {
+	n, _, _ := SDL_GetDisplayName.Call(uintptr(index))
+	if n == 0 {
+		return "", GetError()
+	}
+	return goString(n), nil
+}
+
+func GetDisplayBounds(index int32, r *Rect) error {
+	errc, _, _ := SDL_GetDisplayBounds.Call(uintptr(index), uintptr(unsafe.Pointer(r)))
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func GetDisplayUsableBounds(index int32, r *Rect) error {
+	errc, _, _ := SDL_GetDisplayUsableBounds.Call(uintptr(index), uintptr(unsafe.Pointer(r)))
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func GetDisplayDPI(index int32, ddpi, hdpi, vdpi *float32) error {
+	errc, _, _ := SDL_GetDisplayDPI.Call(
+		uintptr(index),
+		uintptr(unsafe.Pointer(ddpi)),
+		uintptr(unsafe.Pointer(hdpi)),
+		uintptr(unsafe.Pointer(vdpi)),
+	)
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func GetNumDisplayModes(index int32) (int32, error) {
+	n, _, _ := SDL_GetNumDisplayModes.Call(uintptr(index))
+	if int32(n) < 0 {
+		return 0, GetError()
+	}
+	return int32(n), nil
+}
+
+func GetDisplayMode(index, mode int32, m *DisplayMode) error {
+	errc, _, _ := SDL_GetDisplayMode.Call(uintptr(index), uintptr(mode), uintptr(unsafe.Pointer(m)))
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func GetDesktopDisplayMode(index int32, m *DisplayMode) error {
+	errc, _, _ := SDL_GetDesktopDisplayMode.Call(uintptr(index), uintptr(unsafe.Pointer(m)))
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func GetWindowDisplayIndex(w Window) (int32, error) {
+	i, _, _ := SDL_GetWindowDisplayIndex.Call(w.uintptr)
+	if int32(i) < 0 {
+		return 0, GetError()
+	}
+	return int32(i), nil
+}
+
+func SetWindowDisplayMode(w Window, m *DisplayMode) error {
+	errc, _, _ := SDL_SetWindowDisplayMode.Call(w.uintptr, uintptr(unsafe.Pointer(m)))
+	if errc != 0 {
+		return GetError()
+	}
+	return nil
+}
+
+func GetPixelFormatName(format uint32) string {
+	n, _, _ := SDL_GetPixelFormatName.Call(uintptr(format))
+	return goString(n)
+}
@@ -0,0 +1,494 @@
+// Code generated by "go run gen.go"; DO NOT EDIT.
+
+package keyboard
+
+// Scancodes, i.e. physical key positions (from SDL_scancode.h).
+const (
+	ScancodeUnknown            Scancode = 0
+	ScancodeA                  Scancode = 4
+	ScancodeB                  Scancode = 5
+	ScancodeC                  Scancode = 6
+	ScancodeD                  Scancode = 7
+	ScancodeE                  Scancode = 8
+	ScancodeF                  Scancode = 9
+	ScancodeG                  Scancode = 10
+	ScancodeH                  Scancode = 11
+	ScancodeI                  Scancode = 12
+	ScancodeJ                  Scancode = 13
+	ScancodeK                  Scancode = 14
+	ScancodeL                  Scancode = 15
+	ScancodeM                  Scancode = 16
+	ScancodeN                  Scancode = 17
+	ScancodeO                  Scancode = 18
+	ScancodeP                  Scancode = 19
+	ScancodeQ                  Scancode = 20
+	ScancodeR                  Scancode = 21
+	ScancodeS                  Scancode = 22
+	ScancodeT                  Scancode = 23
+	ScancodeU                  Scancode = 24
+	ScancodeV                  Scancode = 25
+	ScancodeW                  Scancode = 26
+	ScancodeX                  Scancode = 27
+	ScancodeY                  Scancode = 28
+	ScancodeZ                  Scancode = 29
+	Scancode1                  Scancode = 30
+	Scancode2                  Scancode = 31
+	Scancode3                  Scancode = 32
+	Scancode4                  Scancode = 33
+	Scancode5                  Scancode = 34
+	Scancode6                  Scancode = 35
+	Scancode7                  Scancode = 36
+	Scancode8                  Scancode = 37
+	Scancode9                  Scancode = 38
+	Scancode0                  Scancode = 39
+	ScancodeReturn             Scancode = 40
+	ScancodeEscape             Scancode = 41
+	ScancodeBackspace          Scancode = 42
+	ScancodeTab                Scancode = 43
+	ScancodeSpace              Scancode = 44
+	ScancodeMinus              Scancode = 45
+	ScancodeEquals             Scancode = 46
+	ScancodeLeftBracket        Scancode = 47
+	ScancodeRightBracket       Scancode = 48
+	ScancodeBackslash          Scancode = 49
+	ScancodeNonUSHash          Scancode = 50
+	ScancodeSemicolon          Scancode = 51
+	ScancodeApostrophe         Scancode = 52
+	ScancodeGrave              Scancode = 53
+	ScancodeComma              Scancode = 54
+	ScancodePeriod             Scancode = 55
+	ScancodeSlash              Scancode = 56
+	ScancodeCapsLock           Scancode = 57
+	ScancodeF1                 Scancode = 58
+	ScancodeF2                 Scancode = 59
+	ScancodeF3                 Scancode = 60
+	ScancodeF4                 Scancode = 61
+	ScancodeF5                 Scancode = 62
+	ScancodeF6                 Scancode = 63
+	ScancodeF7                 Scancode = 64
+	ScancodeF8                 Scancode = 65
+	ScancodeF9                 Scancode = 66
+	ScancodeF10                Scancode = 67
+	ScancodeF11                Scancode = 68
+	ScancodeF12                Scancode = 69
+	ScancodePrintScreen        Scancode = 70
+	ScancodeScrollLock         Scancode = 71
+	ScancodePause              Scancode = 72
+	ScancodeInsert             Scancode = 73
+	ScancodeHome               Scancode = 74
+	ScancodePageUp             Scancode = 75
+	ScancodeDelete             Scancode = 76
+	ScancodeEnd                Scancode = 77
+	ScancodePageDown           Scancode = 78
+	ScancodeRight              Scancode = 79
+	ScancodeLeft               Scancode = 80
+	ScancodeDown               Scancode = 81
+	ScancodeUp                 Scancode = 82
+	ScancodeNumLockClear       Scancode = 83
+	ScancodeKPDivide           Scancode = 84
+	ScancodeKPMultiply         Scancode = 85
+	ScancodeKPMinus            Scancode = 86
+	ScancodeKPPlus             Scancode = 87
+	ScancodeKPEnter            Scancode = 88
+	ScancodeKP1                Scancode = 89
+	ScancodeKP2                Scancode = 90
+	ScancodeKP3                Scancode = 91
+	ScancodeKP4                Scancode = 92
+	ScancodeKP5                Scancode = 93
+	ScancodeKP6                Scancode = 94
+	ScancodeKP7                Scancode = 95
+	ScancodeKP8                Scancode = 96
+	ScancodeKP9                Scancode = 97
+	ScancodeKP0                Scancode = 98
+	ScancodeKPPeriod           Scancode = 99
+	ScancodeNonUSBackslash     Scancode = 100
+	ScancodeApplication        Scancode = 101
+	ScancodePower              Scancode = 102
+	ScancodeKPEquals           Scancode = 103
+	ScancodeF13                Scancode = 104
+	ScancodeF14                Scancode = 105
+	ScancodeF15                Scancode = 106
+	ScancodeF16                Scancode = 107
+	ScancodeF17                Scancode = 108
+	ScancodeF18                Scancode = 109
+	ScancodeF19                Scancode = 110
+	ScancodeF20                Scancode = 111
+	ScancodeF21                Scancode = 112
+	ScancodeF22                Scancode = 113
+	ScancodeF23                Scancode = 114
+	ScancodeF24                Scancode = 115
+	ScancodeExecute            Scancode = 116
+	ScancodeHelp               Scancode = 117
+	ScancodeMenu               Scancode = 118
+	ScancodeSelect             Scancode = 119
+	ScancodeStop               Scancode = 120
+	ScancodeAgain              Scancode = 121
+	ScancodeUndo               Scancode = 122
+	ScancodeCut                Scancode = 123
+	ScancodeCopy               Scancode = 124
+	ScancodePaste              Scancode = 125
+	ScancodeFind               Scancode = 126
+	ScancodeMute               Scancode = 127
+	ScancodeVolumeUp           Scancode = 128
+	ScancodeVolumeDown         Scancode = 129
+	ScancodeKPComma            Scancode = 133
+	ScancodeKPEqualsAS400      Scancode = 134
+	ScancodeInternational1     Scancode = 135
+	ScancodeInternational2     Scancode = 136
+	ScancodeInternational3     Scancode = 137
+	ScancodeInternational4     Scancode = 138
+	ScancodeInternational5     Scancode = 139
+	ScancodeInternational6     Scancode = 140
+	ScancodeInternational7     Scancode = 141
+	ScancodeInternational8     Scancode = 142
+	ScancodeInternational9     Scancode = 143
+	ScancodeLang1              Scancode = 144
+	ScancodeLang2              Scancode = 145
+	ScancodeLang3              Scancode = 146
+	ScancodeLang4              Scancode = 147
+	ScancodeLang5              Scancode = 148
+	ScancodeLang6              Scancode = 149
+	ScancodeLang7              Scancode = 150
+	ScancodeLang8              Scancode = 151
+	ScancodeLang9              Scancode = 152
+	ScancodeAltErase           Scancode = 153
+	ScancodeSysReq             Scancode = 154
+	ScancodeCancel             Scancode = 155
+	ScancodeClear              Scancode = 156
+	ScancodePrior              Scancode = 157
+	ScancodeReturn2            Scancode = 158
+	ScancodeSeparator          Scancode = 159
+	ScancodeOut                Scancode = 160
+	ScancodeOper               Scancode = 161
+	ScancodeClearAgain         Scancode = 162
+	ScancodeCrSel              Scancode = 163
+	ScancodeExSel              Scancode = 164
+	ScancodeKP00               Scancode = 176
+	ScancodeKP000              Scancode = 177
+	ScancodeThousandsSeparator Scancode = 178
+	ScancodeDecimalSeparator   Scancode = 179
+	ScancodeCurrencyUnit       Scancode = 180
+	ScancodeCurrencySubunit    Scancode = 181
+	ScancodeKPLeftParen        Scancode = 182
+	ScancodeKPRightParen       Scancode = 183
+	ScancodeKPLeftBrace        Scancode = 184
+	ScancodeKPRightBrace       Scancode = 185
+	ScancodeKPTab              Scancode = 186
+	ScancodeKPBackspace        Scancode = 187
+	ScancodeKPA                Scancode = 188
+	ScancodeKPB                Scancode = 189
+	ScancodeKPC                Scancode = 190
+	ScancodeKPD                Scancode = 191
+	ScancodeKPE                Scancode = 192
+	ScancodeKPF                Scancode = 193
+	ScancodeKPXor              Scancode = 194
+	ScancodeKPPower            Scancode = 195
+	ScancodeKPPercent          Scancode = 196
+	ScancodeKPLess             Scancode = 197
+	ScancodeKPGreater          Scancode = 198
+	ScancodeKPAmpersand        Scancode = 199
+	ScancodeKPDblAmpersand     Scancode = 200
+	ScancodeKPVerticalBar      Scancode = 201
+	ScancodeKPDblVerticalBar   Scancode = 202
+	ScancodeKPColon            Scancode = 203
+	ScancodeKPHash             Scancode = 204
+	ScancodeKPSpace            Scancode = 205
+	ScancodeKPAt               Scancode = 206
+	ScancodeKPExclam           Scancode = 207
+	ScancodeKPMemStore         Scancode = 208
+	ScancodeKPMemRecall        Scancode = 209
+	ScancodeKPMemClear         Scancode = 210
+	ScancodeKPMemAdd           Scancode = 211
+	ScancodeKPMemSubtract      Scancode = 212
+	ScancodeKPMemMultiply      Scancode = 213
+	ScancodeKPMemDivide        Scancode = 214
+	ScancodeKPPlusMinus        Scancode = 215
+	ScancodeKPClear            Scancode = 216
+	ScancodeKPClearEntry       Scancode = 217
+	ScancodeKPBinary           Scancode = 218
+	ScancodeKPOctal            Scancode = 219
+	ScancodeKPDecimal          Scancode = 220
+	ScancodeKPHexadecimal      Scancode = 221
+	ScancodeLeftCtrl           Scancode = 224
+	ScancodeLeftShift          Scancode = 225
+	ScancodeLeftAlt            Scancode = 226
+	ScancodeLeftGUI            Scancode = 227
+	ScancodeRightCtrl          Scancode = 228
+	ScancodeRightShift         Scancode = 229
+	ScancodeRightAlt           Scancode = 230
+	ScancodeRightGUI           Scancode = 231
+	ScancodeMode               Scancode = 257
+	ScancodeAudioNext          Scancode = 258
+	ScancodeAudioPrev          Scancode = 259
+	ScancodeAudioStop          Scancode = 260
+	ScancodeAudioPlay          Scancode = 261
+	ScancodeAudioMute          Scancode = 262
+	ScancodeMediaSelect        Scancode = 263
+	ScancodeWWW                Scancode = 264
+	ScancodeMail               Scancode = 265
+	ScancodeCalculator         Scancode = 266
+	ScancodeComputer           Scancode = 267
+	ScancodeACSearch           Scancode = 268
+	ScancodeACHome             Scancode = 269
+	ScancodeACBack             Scancode = 270
+	ScancodeACForward          Scancode = 271
+	ScancodeACStop             Scancode = 272
+	ScancodeACRefresh          Scancode = 273
+	ScancodeACBookmarks        Scancode = 274
+	ScancodeBrightnessDown     Scancode = 275
+	ScancodeBrightnessUp       Scancode = 276
+	ScancodeDisplaySwitch      Scancode = 277
+	ScancodeKbdIllumToggle     Scancode = 278
+	ScancodeKbdIllumDown       Scancode = 279
+	ScancodeKbdIllumUp         Scancode = 280
+	ScancodeEject              Scancode = 281
+	ScancodeSleep              Scancode = 282
+	ScancodeApp1               Scancode = 283
+	ScancodeApp2               Scancode = 284
+	ScancodeAudioRewind        Scancode = 285
+	ScancodeAudioFastForward   Scancode = 286
+)
+
+// Keycodes, i.e. key meanings in the current layout (from SDL_keycode.h).
+const (
+	KeycodeUnknown            Keycode = 0x0
+	KeycodeReturn             Keycode = 0xd
+	KeycodeEscape             Keycode = 0x1b
+	KeycodeBackspace          Keycode = 0x8
+	KeycodeTab                Keycode = 0x9
+	KeycodeSpace              Keycode = 0x20
+	KeycodeExclaim            Keycode = 0x21
+	KeycodeQuoteDbl           Keycode = 0x22
+	KeycodeHash               Keycode = 0x23
+	KeycodePercent            Keycode = 0x25
+	KeycodeDollar             Keycode = 0x24
+	KeycodeAmpersand          Keycode = 0x26
+	KeycodeQuote              Keycode = 0x27
+	KeycodeLeftParen          Keycode = 0x28
+	KeycodeRightParen         Keycode = 0x29
+	KeycodeAsterisk           Keycode = 0x2a
+	KeycodePlus               Keycode = 0x2b
+	KeycodeComma              Keycode = 0x2c
+	KeycodeMinus              Keycode = 0x2d
+	KeycodePeriod             Keycode = 0x2e
+	KeycodeSlash              Keycode = 0x2f
+	Keycode0                  Keycode = 0x30
+	Keycode1                  Keycode = 0x31
+	Keycode2                  Keycode = 0x32
+	Keycode3                  Keycode = 0x33
+	Keycode4                  Keycode = 0x34
+	Keycode5                  Keycode = 0x35
+	Keycode6                  Keycode = 0x36
+	Keycode7                  Keycode = 0x37
+	Keycode8                  Keycode = 0x38
+	Keycode9                  Keycode = 0x39
+	KeycodeColon              Keycode = 0x3a
+	KeycodeSemicolon          Keycode = 0x3b
+	KeycodeLess               Keycode = 0x3c
+	KeycodeEquals             Keycode = 0x3d
+	KeycodeGreater            Keycode = 0x3e
+	KeycodeQuestion           Keycode = 0x3f
+	KeycodeAt                 Keycode = 0x40
+	KeycodeLeftBracket        Keycode = 0x5b
+	KeycodeBackslash          Keycode = 0x5c
+	KeycodeRightBracket       Keycode = 0x5d
+	KeycodeCaret              Keycode = 0x5e
+	KeycodeUnderscore         Keycode = 0x5f
+	KeycodeBackquote          Keycode = 0x60
+	KeycodeA                  Keycode = 0x61
+	KeycodeB                  Keycode = 0x62
+	KeycodeC                  Keycode = 0x63
+	KeycodeD                  Keycode = 0x64
+	KeycodeE                  Keycode = 0x65
+	KeycodeF                  Keycode = 0x66
+	KeycodeG                  Keycode = 0x67
+	KeycodeH                  Keycode = 0x68
+	KeycodeI                  Keycode = 0x69
+	KeycodeJ                  Keycode = 0x6a
+	KeycodeK                  Keycode = 0x6b
+	KeycodeL                  Keycode = 0x6c
+	KeycodeM                  Keycode = 0x6d
+	KeycodeN                  Keycode = 0x6e
+	KeycodeO                  Keycode = 0x6f
+	KeycodeP                  Keycode = 0x70
+	KeycodeQ                  Keycode = 0x71
+	KeycodeR                  Keycode = 0x72
+	KeycodeS                  Keycode = 0x73
+	KeycodeT                  Keycode = 0x74
+	KeycodeU                  Keycode = 0x75
+	KeycodeV                  Keycode = 0x76
+	KeycodeW                  Keycode = 0x77
+	KeycodeX                  Keycode = 0x78
+	KeycodeY                  Keycode = 0x79
+	KeycodeZ                  Keycode = 0x7a
+	KeycodeCapsLock           Keycode = 0x40000039
+	KeycodeF1                 Keycode = 0x4000003a
+	KeycodeF2                 Keycode = 0x4000003b
+	KeycodeF3                 Keycode = 0x4000003c
+	KeycodeF4                 Keycode = 0x4000003d
+	KeycodeF5                 Keycode = 0x4000003e
+	KeycodeF6                 Keycode = 0x4000003f
+	KeycodeF7                 Keycode = 0x40000040
+	KeycodeF8                 Keycode = 0x40000041
+	KeycodeF9                 Keycode = 0x40000042
+	KeycodeF10                Keycode = 0x40000043
+	KeycodeF11                Keycode = 0x40000044
+	KeycodeF12                Keycode = 0x40000045
+	KeycodePrintScreen        Keycode = 0x40000046
+	KeycodeScrollLock         Keycode = 0x40000047
+	KeycodePause              Keycode = 0x40000048
+	KeycodeInsert             Keycode = 0x40000049
+	KeycodeHome               Keycode = 0x4000004a
+	KeycodePageUp             Keycode = 0x4000004b
+	KeycodeDelete             Keycode = 0x7f
+	KeycodeEnd                Keycode = 0x4000004d
+	KeycodePageDown           Keycode = 0x4000004e
+	KeycodeRight              Keycode = 0x4000004f
+	KeycodeLeft               Keycode = 0x40000050
+	KeycodeDown               Keycode = 0x40000051
+	KeycodeUp                 Keycode = 0x40000052
+	KeycodeNumLockClear       Keycode = 0x40000053
+	KeycodeKPDivide           Keycode = 0x40000054
+	KeycodeKPMultiply         Keycode = 0x40000055
+	KeycodeKPMinus            Keycode = 0x40000056
+	KeycodeKPPlus             Keycode = 0x40000057
+	KeycodeKPEnter            Keycode = 0x40000058
+	KeycodeKP1                Keycode = 0x40000059
+	KeycodeKP2                Keycode = 0x4000005a
+	KeycodeKP3                Keycode = 0x4000005b
+	KeycodeKP4                Keycode = 0x4000005c
+	KeycodeKP5                Keycode = 0x4000005d
+	KeycodeKP6                Keycode = 0x4000005e
+	KeycodeKP7                Keycode = 0x4000005f
+	KeycodeKP8                Keycode = 0x40000060
+	KeycodeKP9                Keycode = 0x40000061
+	KeycodeKP0                Keycode = 0x40000062
+	KeycodeKPPeriod           Keycode = 0x40000063
+	KeycodeApplication        Keycode = 0x40000065
+	KeycodePower              Keycode = 0x40000066
+	KeycodeKPEquals           Keycode = 0x40000067
+	KeycodeF13                Keycode = 0x40000068
+	KeycodeF14                Keycode = 0x40000069
+	KeycodeF15                Keycode = 0x4000006a
+	KeycodeF16                Keycode = 0x4000006b
+	KeycodeF17                Keycode = 0x4000006c
+	KeycodeF18                Keycode = 0x4000006d
+	KeycodeF19                Keycode = 0x4000006e
+	KeycodeF20                Keycode = 0x4000006f
+	KeycodeF21                Keycode = 0x40000070
+	KeycodeF22                Keycode = 0x40000071
+	KeycodeF23                Keycode = 0x40000072
+	KeycodeF24                Keycode = 0x40000073
+	KeycodeExecute            Keycode = 0x40000074
+	KeycodeHelp               Keycode = 0x40000075
+	KeycodeMenu               Keycode = 0x40000076
+	KeycodeSelect             Keycode = 0x40000077
+	KeycodeStop               Keycode = 0x40000078
+	KeycodeAgain              Keycode = 0x40000079
+	KeycodeUndo               Keycode = 0x4000007a
+	KeycodeCut                Keycode = 0x4000007b
+	KeycodeCopy               Keycode = 0x4000007c
+	KeycodePaste              Keycode = 0x4000007d
+	KeycodeFind               Keycode = 0x4000007e
+	KeycodeMute               Keycode = 0x4000007f
+	KeycodeVolumeUp           Keycode = 0x40000080
+	KeycodeVolumeDown         Keycode = 0x40000081
+	KeycodeKPComma            Keycode = 0x40000085
+	KeycodeKPEqualsAS400      Keycode = 0x40000086
+	KeycodeAltErase           Keycode = 0x40000099
+	KeycodeSysReq             Keycode = 0x4000009a
+	KeycodeCancel             Keycode = 0x4000009b
+	KeycodeClear              Keycode = 0x4000009c
+	KeycodePrior              Keycode = 0x4000009d
+	KeycodeReturn2            Keycode = 0x4000009e
+	KeycodeSeparator          Keycode = 0x4000009f
+	KeycodeOut                Keycode = 0x400000a0
+	KeycodeOper               Keycode = 0x400000a1
+	KeycodeClearAgain         Keycode = 0x400000a2
+	KeycodeCrSel              Keycode = 0x400000a3
+	KeycodeExSel              Keycode = 0x400000a4
+	KeycodeKP00               Keycode = 0x400000b0
+	KeycodeKP000              Keycode = 0x400000b1
+	KeycodeThousandsSeparator Keycode = 0x400000b2
+	KeycodeDecimalSeparator   Keycode = 0x400000b3
+	KeycodeCurrencyUnit       Keycode = 0x400000b4
+	KeycodeCurrencySubunit    Keycode = 0x400000b5
+	KeycodeKPLeftParen        Keycode = 0x400000b6
+	KeycodeKPRightParen       Keycode = 0x400000b7
+	KeycodeKPLeftBrace        Keycode = 0x400000b8
+	KeycodeKPRightBrace       Keycode = 0x400000b9
+	KeycodeKPTab              Keycode = 0x400000ba
+	KeycodeKPBackspace        Keycode = 0x400000bb
+	KeycodeKPA                Keycode = 0x400000bc
+	KeycodeKPB                Keycode = 0x400000bd
+	KeycodeKPC                Keycode = 0x400000be
+	KeycodeKPD                Keycode = 0x400000bf
+	KeycodeKPE                Keycode = 0x400000c0
+	KeycodeKPF                Keycode = 0x400000c1
+	KeycodeKPXor              Keycode = 0x400000c2
+	KeycodeKPPower            Keycode = 0x400000c3
+	KeycodeKPPercent          Keycode = 0x400000c4
+	KeycodeKPLess             Keycode = 0x400000c5
+	KeycodeKPGreater          Keycode = 0x400000c6
+	KeycodeKPAmpersand        Keycode = 0x400000c7
+	KeycodeKPDblAmpersand     Keycode = 0x400000c8
+	KeycodeKPVerticalBar      Keycode = 0x400000c9
+	KeycodeKPDblVerticalBar   Keycode = 0x400000ca
+	KeycodeKPColon            Keycode = 0x400000cb
+	KeycodeKPHash             Keycode = 0x400000cc
+	KeycodeKPSpace            Keycode = 0x400000cd
+	KeycodeKPAt               Keycode = 0x400000ce
+	KeycodeKPExclam           Keycode = 0x400000cf
+	KeycodeKPMemStore         Keycode = 0x400000d0
+	KeycodeKPMemRecall        Keycode = 0x400000d1
+	KeycodeKPMemClear         Keycode = 0x400000d2
+	KeycodeKPMemAdd           Keycode = 0x400000d3
+	KeycodeKPMemSubtract      Keycode = 0x400000d4
+	KeycodeKPMemMultiply      Keycode = 0x400000d5
+	KeycodeKPMemDivide        Keycode = 0x400000d6
+	KeycodeKPPlusMinus        Keycode = 0x400000d7
+	KeycodeKPClear            Keycode = 0x400000d8
+	KeycodeKPClearEntry       Keycode = 0x400000d9
+	KeycodeKPBinary           Keycode = 0x400000da
+	KeycodeKPOctal            Keycode = 0x400000db
+	KeycodeKPDecimal          Keycode = 0x400000dc
+	KeycodeKPHexadecimal      Keycode = 0x400000dd
+	KeycodeLeftCtrl           Keycode = 0x400000e0
+	KeycodeLeftShift          Keycode = 0x400000e1
+	KeycodeLeftAlt            Keycode = 0x400000e2
+	KeycodeLeftGUI            Keycode = 0x400000e3
+	KeycodeRightCtrl          Keycode = 0x400000e4
+	KeycodeRightShift         Keycode = 0x400000e5
+	KeycodeRightAlt           Keycode = 0x400000e6
+	KeycodeRightGUI           Keycode = 0x400000e7
+	KeycodeMode               Keycode = 0x40000101
+	KeycodeAudioNext          Keycode = 0x40000102
+	KeycodeAudioPrev          Keycode = 0x40000103
+	KeycodeAudioStop          Keycode = 0x40000104
+	KeycodeAudioPlay          Keycode = 0x40000105
+	KeycodeAudioMute          Keycode = 0x40000106
+	KeycodeMediaSelect        Keycode = 0x40000107
+	KeycodeWWW                Keycode = 0x40000108
+	KeycodeMail               Keycode = 0x40000109
+	KeycodeCalculator         Keycode = 0x4000010a
+	KeycodeComputer           Keycode = 0x4000010b
+	KeycodeACSearch           Keycode = 0x4000010c
+	KeycodeACHome             Keycode = 0x4000010d
+	KeycodeACBack             Keycode = 0x4000010e
+	KeycodeACForward          Keycode = 0x4000010f
+	KeycodeACStop             Keycode = 0x40000110
+	KeycodeACRefresh          Keycode = 0x40000111
+	KeycodeACBookmarks        Keycode = 0x40000112
+	KeycodeBrightnessDown     Keycode = 0x40000113
+	KeycodeBrightnessUp       Keycode = 0x40000114
+	KeycodeDisplaySwitch      Keycode = 0x40000115
+	KeycodeKbdIllumToggle     Keycode = 0x40000116
+	KeycodeKbdIllumDown       Keycode = 0x40000117
+	KeycodeKbdIllumUp         Keycode = 0x40000118
+	KeycodeEject              Keycode = 0x40000119
+	KeycodeSleep              Keycode = 0x4000011a
+	KeycodeApp1               Keycode = 0x4000011b
+	KeycodeApp2               Keycode = 0x4000011c
+	KeycodeAudioRewind        Keycode = 0x4000011d
+	KeycodeAudioFastForward   Keycode = 0x4000011e
+)
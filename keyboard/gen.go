@@ -0,0 +1,204 @@
+// +build ignore
+
+// This program generates codes.go from the SDL headers. It can be invoked by
+// running go generate, with the SDL include directory given by the -sdl flag.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var sdlDir = flag.String("sdl", "/usr/include/SDL2", "directory of the SDL headers")
+
+type constant struct {
+	name  string
+	value int64
+}
+
+var (
+	scancodeRe = regexp.MustCompile(`^\s*SDL_SCANCODE_(\w+)\s*=\s*(\d+)\s*,`)
+	keycodeRe  = regexp.MustCompile(`^\s*SDLK_(\w+)\s*=\s*('(?:\\.|[^'\\])+'|[^,\s]+)\s*,`)
+	toKeyRe    = regexp.MustCompile(`^SDL_SCANCODE_TO_KEYCODE\(SDL_SCANCODE_(\w+)\)$`)
+)
+
+// The SDL names are mostly made of words in capitals; this table gives the Go
+// spelling of those that are not simply capitalized.
+var words = map[string]string{
+	"AC":                 "AC",
+	"KP":                 "KP",
+	"GUI":                "GUI",
+	"WWW":                "WWW",
+	"AS400":              "AS400",
+	"LCTRL":              "LeftCtrl",
+	"LSHIFT":             "LeftShift",
+	"LALT":               "LeftAlt",
+	"LGUI":               "LeftGUI",
+	"RCTRL":              "RightCtrl",
+	"RSHIFT":             "RightShift",
+	"RALT":               "RightAlt",
+	"RGUI":               "RightGUI",
+	"PAGEUP":             "PageUp",
+	"PAGEDOWN":           "PageDown",
+	"CAPSLOCK":           "CapsLock",
+	"SCROLLLOCK":         "ScrollLock",
+	"NUMLOCKCLEAR":       "NumLockClear",
+	"PRINTSCREEN":        "PrintScreen",
+	"LEFTBRACKET":        "LeftBracket",
+	"RIGHTBRACKET":       "RightBracket",
+	"LEFTPAREN":          "LeftParen",
+	"RIGHTPAREN":         "RightParen",
+	"LEFTBRACE":          "LeftBrace",
+	"RIGHTBRACE":         "RightBrace",
+	"NONUSHASH":          "NonUSHash",
+	"NONUSBACKSLASH":     "NonUSBackslash",
+	"VOLUMEUP":           "VolumeUp",
+	"VOLUMEDOWN":         "VolumeDown",
+	"EQUALSAS400":        "EqualsAS400",
+	"ALTERASE":           "AltErase",
+	"SYSREQ":             "SysReq",
+	"CLEARAGAIN":         "ClearAgain",
+	"CRSEL":              "CrSel",
+	"EXSEL":              "ExSel",
+	"THOUSANDSSEPARATOR": "ThousandsSeparator",
+	"DECIMALSEPARATOR":   "DecimalSeparator",
+	"CURRENCYUNIT":       "CurrencyUnit",
+	"CURRENCYSUBUNIT":    "CurrencySubunit",
+	"DBLAMPERSAND":       "DblAmpersand",
+	"VERTICALBAR":        "VerticalBar",
+	"DBLVERTICALBAR":     "DblVerticalBar",
+	"MEMSTORE":           "MemStore",
+	"MEMRECALL":          "MemRecall",
+	"MEMCLEAR":           "MemClear",
+	"MEMADD":             "MemAdd",
+	"MEMSUBTRACT":        "MemSubtract",
+	"MEMMULTIPLY":        "MemMultiply",
+	"MEMDIVIDE":          "MemDivide",
+	"PLUSMINUS":          "PlusMinus",
+	"CLEARENTRY":         "ClearEntry",
+	"AUDIONEXT":          "AudioNext",
+	"AUDIOPREV":          "AudioPrev",
+	"AUDIOSTOP":          "AudioStop",
+	"AUDIOPLAY":          "AudioPlay",
+	"AUDIOMUTE":          "AudioMute",
+	"AUDIOREWIND":        "AudioRewind",
+	"AUDIOFASTFORWARD":   "AudioFastForward",
+	"MEDIASELECT":        "MediaSelect",
+	"BRIGHTNESSDOWN":     "BrightnessDown",
+	"BRIGHTNESSUP":       "BrightnessUp",
+	"DISPLAYSWITCH":      "DisplaySwitch",
+	"KBDILLUMTOGGLE":     "KbdIllumToggle",
+	"KBDILLUMDOWN":       "KbdIllumDown",
+	"KBDILLUMUP":         "KbdIllumUp",
+	"QUOTEDBL":           "QuoteDbl",
+	"BACKQUOTE":          "Backquote",
+}
+
+func main() {
+	flag.Parse()
+	log.SetFlags(0)
+	log.SetPrefix("keyboard/gen: ")
+
+	scancodes := parse(filepath.Join(*sdlDir, "SDL_scancode.h"), scancodeRe, nil)
+	byName := map[string]int64{}
+	for _, c := range scancodes {
+		byName[c.name] = c.value
+	}
+	keycodes := parse(filepath.Join(*sdlDir, "SDL_keycode.h"), keycodeRe, byName)
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by \"go run gen.go\"; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package keyboard\n\n")
+	fmt.Fprintf(&b, "// Scancodes, i.e. physical key positions (from SDL_scancode.h).\n")
+	fmt.Fprintf(&b, "const (\n")
+	for _, c := range scancodes {
+		fmt.Fprintf(&b, "\tScancode%s Scancode = %d\n", goName(c.name), c.value)
+	}
+	fmt.Fprintf(&b, ")\n\n")
+	fmt.Fprintf(&b, "// Keycodes, i.e. key meanings in the current layout (from SDL_keycode.h).\n")
+	fmt.Fprintf(&b, "const (\n")
+	for _, c := range keycodes {
+		fmt.Fprintf(&b, "\tKeycode%s Keycode = %#x\n", goName(c.name), c.value)
+	}
+	fmt.Fprintf(&b, ")\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = ioutil.WriteFile("codes.go", src, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parse returns the enum values of a header, matched by re. The value is either
+// a number, a character literal, or a scancode conversion (resolved with
+// scancodes).
+func parse(path string, re *regexp.Regexp, scancodes map[string]int64) []constant {
+	f, err := os.Open(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	var cc []constant
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		m := re.FindStringSubmatch(s.Text())
+		if m == nil {
+			continue
+		}
+		v, err := value(m[2], scancodes)
+		if err != nil {
+			log.Fatalf("%s: %s: %v", path, m[1], err)
+		}
+		cc = append(cc, constant{name: m[1], value: v})
+	}
+	if err := s.Err(); err != nil {
+		log.Fatal(err)
+	}
+	return cc
+}
+
+func value(s string, scancodes map[string]int64) (int64, error) {
+	if m := toKeyRe.FindStringSubmatch(s); m != nil {
+		v, ok := scancodes[m[1]]
+		if !ok {
+			return 0, fmt.Errorf("unknown scancode %s", m[1])
+		}
+		return v | 1<<30, nil
+	}
+	if strings.HasPrefix(s, "'") {
+		r, _, _, err := strconv.UnquoteChar(s[1:len(s)-1], '\'')
+		return int64(r), err
+	}
+	return strconv.ParseInt(s, 0, 64)
+}
+
+// goName converts an SDL name (e.g. "KP_PAGEUP") to Go (e.g. "KPPageUp").
+func goName(s string) string {
+	var n string
+	for _, w := range strings.Split(s, "_") {
+		if g, ok := words[w]; ok {
+			n += g
+			continue
+		}
+		if strings.ToLower(w) == w {
+			// keycodes of letters are in lower case
+			w = strings.ToUpper(w)
+		}
+		n += w[:1] + strings.ToLower(w[1:])
+	}
+	return n
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2013-2018 Laurent Moussault. All rights reserved.
+// Licensed under a simplified BSD license (see LICENSE file).
+
+// Package keyboard provides the key events and the keyboard state. Both are
+// updated by window.PollEvents.
+package keyboard
+
+//go:generate go run gen.go
+
+import (
+	"github.com/cozely/platform/internal/events"
+	"github.com/cozely/platform/internal/sdl"
+)
+
+// A Scancode identifies the physical position of a key, independently of the
+// keyboard layout.
+type Scancode int32
+
+// A Keycode identifies the meaning of a key in the current keyboard layout.
+type Keycode int32
+
+// Mod is a set of modifier flags.
+type Mod uint16
+
+// Modifier flags.
+const (
+	ModNone       Mod = 0x0000
+	ModLeftShift  Mod = 0x0001
+	ModRightShift Mod = 0x0002
+	ModLeftCtrl   Mod = 0x0040
+	ModRightCtrl  Mod = 0x0080
+	ModLeftAlt    Mod = 0x0100
+	ModRightAlt   Mod = 0x0200
+	ModLeftGUI    Mod = 0x0400
+	ModRightGUI   Mod = 0x0800
+	ModNum        Mod = 0x1000
+	ModCaps       Mod = 0x2000
+	ModMode       Mod = 0x4000
+
+	ModShift = ModLeftShift | ModRightShift
+	ModCtrl  = ModLeftCtrl | ModRightCtrl
+	ModAlt   = ModLeftAlt | ModRightAlt
+	ModGUI   = ModLeftGUI | ModRightGUI
+)
+
+// KeyDown is sent when a key is pressed.
+type KeyDown struct {
+	WindowID uint32 // the window with keyboard focus, 0 if none
+	Scancode Scancode
+	Keycode  Keycode
+	Mod      Mod
+}
+
+// KeyRepeat is sent when a key is held down long enough to auto-repeat.
+type KeyRepeat struct {
+	WindowID uint32 // the window with keyboard focus, 0 if none
+	Scancode Scancode
+	Keycode  Keycode
+	Mod      Mod
+}
+
+// KeyUp is sent when a key is released.
+type KeyUp struct {
+	WindowID uint32 // the window with keyboard focus, 0 if none
+	Scancode Scancode
+	Keycode  Keycode
+	Mod      Mod
+}
+
+func init() {
+	events.Register(translate)
+}
+
+func translate(e *sdl.Event) interface{} {
+	if e.Type != sdl.EventKeyDown && e.Type != sdl.EventKeyUp {
+		return nil
+	}
+
+	k := e.Key()
+	s, c, m := Scancode(k.Keysym.Scancode), Keycode(k.Keysym.Sym), Mod(k.Keysym.Mod)
+	switch {
+	case e.Type == sdl.EventKeyUp:
+		return KeyUp{WindowID: k.WindowID, Scancode: s, Keycode: c, Mod: m}
+	case k.Repeat != 0:
+		return KeyRepeat{WindowID: k.WindowID, Scancode: s, Keycode: c, Mod: m}
+	default:
+		return KeyDown{WindowID: k.WindowID, Scancode: s, Keycode: c, Mod: m}
+	}
+}
+
+var state []uint8
+
+// IsPressed returns true if the key at the physical position s is currently
+// pressed. The state is updated by window.PollEvents.
+func IsPressed(s Scancode) bool {
+	if state == nil {
+		state = sdl.GetKeyboardState()
+	}
+	return s >= 0 && int(s) < len(state) && state[s] != 0
+}
+
+// Modifiers returns the modifier flags currently active.
+func Modifiers() Mod {
+	return Mod(sdl.GetModState())
+}
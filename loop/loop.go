@@ -0,0 +1,179 @@
+// Copyright (c) 2013-2018 Laurent Moussault. All rights reserved.
+// Licensed under a simplified BSD license (see LICENSE file).
+
+// Package loop provides a game loop for a window: a fixed-timestep update,
+// for deterministic simulation, and a variable-rate render, which receives an
+// interpolation factor between the last two updates.
+package loop
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/cozely/platform/window"
+)
+
+// An Option configures the loop.
+type Option func(*config) error
+
+type config struct {
+	step     time.Duration
+	maxSteps int
+	pause    bool
+	events   func(window.Event)
+}
+
+// UpdateRate sets the number of updates per second (default 60).
+func UpdateRate(hz float64) Option {
+	return func(c *config) error {
+		step := time.Duration(float64(time.Second) / hz)
+		if !(hz > 0) || step <= 0 {
+			return fmt.Errorf("invalid update rate %v", hz)
+		}
+		c.step = step
+		return nil
+	}
+}
+
+// MaxSteps sets the maximum number of updates run before each render (default
+// 5). When the updates cannot keep up, the remaining time is dropped: the
+// simulation slows down instead of falling further behind.
+func MaxSteps(n int) Option {
+	return func(c *config) error {
+		c.maxSteps = n
+		return nil
+	}
+}
+
+// PauseOnFocusLoss suspends the updates and renders after the window loses the
+// focus, until it gains it back (enabled by default).
+func PauseOnFocusLoss(enable bool) Option {
+	return func(c *config) error {
+		c.pause = enable
+		return nil
+	}
+}
+
+// Events sets a function called for each event collected by the loop, before
+// the updates.
+func Events(f func(window.Event)) Option {
+	return func(c *config) error {
+		c.events = f
+		return nil
+	}
+}
+
+var stopped int32
+
+// Stop makes Run return, after the current frame. It can be called from any
+// goroutine. If Run is not running, the next call to Run returns immediately.
+func Stop() {
+	atomic.StoreInt32(&stopped, 1)
+}
+
+// stopRequested returns true if Stop has been called, and clears the request:
+// each call to Stop ends one Run.
+func stopRequested() bool {
+	return atomic.CompareAndSwapInt32(&stopped, 1, 0)
+}
+
+// Run runs the loop until the Quit event is received, Stop is called, or the
+// window is closed. Each frame, it polls the events, calls update as many times
+// as needed to catch up with the elapsed time (with dt the fixed timestep in
+// seconds), then calls render, and presents the window. The alpha given to
+// render is the fraction of a timestep elapsed since the last update, to
+// interpolate the state.
+//
+// Run returns nil when the loop is stopped or the window closed, and otherwise
+// the error that ended it (returned by Present).
+//
+// Run must be called from the thread that created the window.
+func Run(w *window.Window, update func(dt float64), render func(alpha float64), o ...Option) error {
+	c := config{
+		step:     time.Second / 60,
+		maxSteps: 5,
+		pause:    true,
+	}
+	for _, o := range o {
+		err := o(&c)
+		if err != nil {
+			return fmt.Errorf("loop.Run: %w", err)
+		}
+	}
+
+	s := stepper{step: c.step, maxSteps: c.maxSteps}
+	paused := false
+	prev := time.Now()
+
+	for !stopRequested() {
+		window.PollEvents()
+		for _, e := range window.Events() {
+			if c.events != nil {
+				c.events(e)
+			}
+			switch e := e.(type) {
+			case window.Quit:
+				Stop()
+			case window.FocusLost:
+				if e.WindowID == w.ID() && c.pause {
+					paused = true
+				}
+			case window.FocusGained:
+				if e.WindowID == w.ID() {
+					paused = false
+				}
+			}
+		}
+		if stopRequested() {
+			return nil
+		}
+
+		now := time.Now()
+		elapsed := now.Sub(prev)
+		prev = now
+		if paused {
+			// Nothing to do until the focus comes back
+			time.Sleep(10 * time.Millisecond)
+			continue
+		}
+
+		n, alpha := s.advance(elapsed)
+		dt := c.step.Seconds()
+		for i := 0; i < n; i++ {
+			update(dt)
+		}
+		render(alpha)
+		err := w.Present()
+		if errors.Is(err, window.ErrClosed) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// stepper accumulates the elapsed time into fixed timesteps.
+type stepper struct {
+	step     time.Duration
+	maxSteps int
+	acc      time.Duration
+}
+
+// advance adds elapsed to the accumulated time, and returns the number of
+// updates to run and the interpolation factor for the render.
+func (s *stepper) advance(elapsed time.Duration) (int, float64) {
+	s.acc += elapsed
+	n := int(s.acc / s.step)
+	if s.maxSteps > 0 && n > s.maxSteps {
+		// Drop the whole steps that cannot be caught up
+		n = s.maxSteps
+		s.acc %= s.step
+	} else {
+		s.acc -= time.Duration(n) * s.step
+	}
+	return n, float64(s.acc) / float64(s.step)
+}
@@ -0,0 +1,86 @@
+package loop
+
+import (
+	"math"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/cozely/platform/window"
+)
+
+func TestStepper(t *testing.T) {
+	s := stepper{step: 10 * time.Millisecond, maxSteps: 5}
+
+	cases := []struct {
+		elapsed time.Duration
+		n       int
+		alpha   float64
+	}{
+		{5 * time.Millisecond, 0, 0.5},
+		{5 * time.Millisecond, 1, 0},
+		{25 * time.Millisecond, 2, 0.5},
+		{15 * time.Millisecond, 2, 0},
+		// Spiral of death: the steps beyond the cap are dropped
+		{1 * time.Second, 5, 0},
+		{73 * time.Millisecond, 5, 0.3},
+		{7 * time.Millisecond, 1, 0},
+	}
+	for i, c := range cases {
+		n, alpha := s.advance(c.elapsed)
+		if n != c.n || math.Abs(alpha-c.alpha) > 1e-9 {
+			t.Errorf("case %d: advance(%v) = %d, %.3f; want %d, %.3f", i, c.elapsed, n, alpha, c.n, c.alpha)
+		}
+	}
+}
+
+func TestUpdateRate(t *testing.T) {
+	for _, hz := range []float64{0, -60, math.NaN(), 1e12} {
+		var c config
+		if err := UpdateRate(hz)(&c); err == nil {
+			t.Errorf("UpdateRate(%v) accepted, step = %v", hz, c.step)
+		}
+	}
+	var c config
+	if err := UpdateRate(50)(&c); err != nil || c.step != 20*time.Millisecond {
+		t.Errorf("UpdateRate(50): step = %v, error %v", c.step, err)
+	}
+}
+
+func TestRun(t *testing.T) {
+	// The context is current on the thread that created it
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	w, err := window.New(window.Headless(true), window.Size(32, 32))
+	if err != nil {
+		t.Skipf("headless window not available: %v", err)
+	}
+	defer w.Close()
+
+	frames := 0
+	update := func(dt float64) {}
+
+	// A Stop before Run ends the next Run, and only that one
+	Stop()
+	err = Run(w, update, func(alpha float64) { frames++ })
+	if err != nil || frames != 0 {
+		t.Errorf("Run after Stop = %v, with %d frames; want nil, 0", err, frames)
+	}
+
+	// A headless window never has the focus, which must not pause the loop
+	err = Run(w, update, func(alpha float64) {
+		frames++
+		if frames == 3 {
+			Stop()
+		}
+	})
+	if err != nil || frames != 3 {
+		t.Errorf("Run = %v, with %d frames; want nil, 3", err, frames)
+	}
+
+	w.Close()
+	if err := Run(w, update, func(alpha float64) {}); err != nil {
+		t.Errorf("Run on a closed window = %v, want nil", err)
+	}
+}
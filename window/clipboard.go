@@ -0,0 +1,135 @@
+package window
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cozely/platform/internal/sdl"
+)
+
+// Clipboard returns the text content of the clipboard, or an empty string if
+// it has none. Invalid UTF-8 sequences are replaced by U+FFFD.
+func Clipboard() (string, error) {
+	checkThread("window.Clipboard")
+	err := acquireVideo("")
+	if err != nil {
+		return "", fmt.Errorf("window.Clipboard: %w", err)
+	}
+	defer releaseVideo()
+
+	t, err := sdl.GetClipboardText()
+	if err != nil {
+		return "", fmt.Errorf("window.Clipboard: %w", sdlError("SDL_GetClipboardText", err))
+	}
+	return strings.ToValidUTF8(t, "�"), nil
+}
+
+// SetClipboard replaces the content of the clipboard with text. Invalid UTF-8
+// sequences are replaced by U+FFFD; the text cannot contain null bytes. On
+// some systems (e.g. X11), the text is only available while a window is
+// opened.
+func SetClipboard(text string) error {
+	checkThread("window.SetClipboard")
+	t, err := clipboardText(text)
+	if err == nil {
+		err = acquireVideo("")
+	}
+	if err != nil {
+		return fmt.Errorf("window.SetClipboard: %w", err)
+	}
+	defer releaseVideo()
+
+	err = sdl.SetClipboardText(t)
+	if err != nil {
+		return fmt.Errorf("window.SetClipboard: %w", sdlError("SDL_SetClipboardText", err))
+	}
+	return nil
+}
+
+// HasClipboardText returns true if the clipboard contains non-empty text.
+func HasClipboardText() bool {
+	checkThread("window.HasClipboardText")
+	if acquireVideo("") != nil {
+		return false
+	}
+	defer releaseVideo()
+	return sdl.HasClipboardText()
+}
+
+// PrimarySelection returns the content of the primary selection, i.e. the
+// text last selected, pasted with the middle button on X11 and Wayland. It
+// returns ErrNotSupported on the other platforms, and with versions of SDL
+// before 2.26.
+func PrimarySelection() (string, error) {
+	checkThread("window.PrimarySelection")
+	err := setupPrimarySelection()
+	if err != nil {
+		return "", fmt.Errorf("window.PrimarySelection: %w", err)
+	}
+	defer releaseVideo()
+
+	t, err := sdl.GetPrimarySelectionText()
+	if err != nil {
+		return "", fmt.Errorf("window.PrimarySelection: %w", sdlError("SDL_GetPrimarySelectionText", err))
+	}
+	return strings.ToValidUTF8(t, "�"), nil
+}
+
+// SetPrimarySelection replaces the content of the primary selection with
+// text (see PrimarySelection and SetClipboard).
+func SetPrimarySelection(text string) error {
+	checkThread("window.SetPrimarySelection")
+	t, err := clipboardText(text)
+	if err == nil {
+		err = setupPrimarySelection()
+	}
+	if err != nil {
+		return fmt.Errorf("window.SetPrimarySelection: %w", err)
+	}
+	defer releaseVideo()
+
+	err = sdl.SetPrimarySelectionText(t)
+	if err != nil {
+		return fmt.Errorf("window.SetPrimarySelection: %w", sdlError("SDL_SetPrimarySelectionText", err))
+	}
+	return nil
+}
+
+// HasPrimarySelectionText returns true if the primary selection contains
+// non-empty text.
+func HasPrimarySelectionText() bool {
+	checkThread("window.HasPrimarySelectionText")
+	if setupPrimarySelection() != nil {
+		return false
+	}
+	defer releaseVideo()
+	return sdl.HasPrimarySelectionText()
+}
+
+// setupPrimarySelection acquires the video subsystem (see acquireVideo), and
+// checks that the primary selection is available. On success, the caller must
+// call releaseVideo.
+func setupPrimarySelection() error {
+	if !sdl.PrimarySelectionSupported() {
+		return ErrNotSupported
+	}
+	err := acquireVideo("")
+	if err != nil {
+		return err
+	}
+	switch sdl.GetCurrentVideoDriver() {
+	case "x11", "wayland":
+		return nil
+	}
+	releaseVideo()
+	return ErrNotSupported
+}
+
+// clipboardText prepares text for the clipboard.
+func clipboardText(text string) (string, error) {
+	if strings.IndexByte(text, 0) >= 0 {
+		return "", errors.New("text contains a null byte")
+	}
+	return strings.ToValidUTF8(text, "�"), nil
+}
@@ -0,0 +1,38 @@
+package window
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestClipboardText(t *testing.T) {
+	if s, err := clipboardText("héllo\xffwörld"); err != nil || s != "héllo�wörld" {
+		t.Errorf("clipboardText(invalid UTF-8) = %q, %v", s, err)
+	}
+	if _, err := clipboardText("a\x00b"); err == nil {
+		t.Errorf("clipboardText with a null byte did not fail")
+	}
+}
+
+func TestClipboard(t *testing.T) {
+	w := newHeadless(t)
+	defer closeHeadless(w)
+
+	err := SetClipboard("copié")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !HasClipboardText() {
+		t.Errorf("HasClipboardText() = false after SetClipboard")
+	}
+	s, err := Clipboard()
+	if err != nil || s != "copié" {
+		t.Errorf("Clipboard() = %q, %v, want %q", s, err, "copié")
+	}
+
+	// The offscreen driver has no primary selection
+	_, err = PrimarySelection()
+	if !errors.Is(err, ErrNotSupported) {
+		t.Errorf("PrimarySelection() error = %v, want ErrNotSupported", err)
+	}
+}
@@ -0,0 +1,216 @@
+package window
+
+import (
+	"fmt"
+
+	"github.com/cozely/platform/internal/sdl"
+)
+
+// Profile identifies an OpenGL API profile.
+type Profile int32
+
+// OpenGL profiles.
+const (
+	GLCore   = Profile(sdl.GLContextProfileCore)
+	GLCompat = Profile(sdl.GLContextProfileCompatibility)
+	GLES     = Profile(sdl.GLContextProfileES)
+)
+
+func (p Profile) String() string {
+	switch p {
+	case GLCore:
+		return "Core"
+	case GLCompat:
+		return "Compatibility"
+	case GLES:
+		return "ES"
+	}
+	return "(unknown)"
+}
+
+// contextConfig holds the attributes used to create the OpenGL context and the
+// default framebuffer.
+type contextConfig struct {
+	profile           Profile
+	major, minor      int32
+	depthBits         int32
+	stencilBits       int32
+	multisample       int32
+	srgb              bool
+	forwardCompatible bool
+	robust            bool
+	noError           bool
+	share             *Window
+}
+
+type version struct {
+	major, minor int32
+}
+
+// fallbacks lists, for each profile, the versions tried in order when the
+// creation of a context fails. The minimums are the first versions with the
+// features needed by this package.
+var fallbacks = map[Profile][]version{
+	GLES:     {{3, 2}, {3, 1}, {3, 0}},
+	GLCore:   {{4, 6}, {4, 5}, {4, 4}, {4, 3}, {4, 2}, {4, 1}, {4, 0}, {3, 3}, {3, 2}},
+	GLCompat: {{4, 6}, {4, 5}, {4, 4}, {4, 3}, {4, 2}, {4, 1}, {4, 0}, {3, 3}, {3, 2}, {3, 1}, {3, 0}},
+}
+
+// versions returns the versions to try: the requested one, followed by the
+// lower versions of the same profile.
+func (c *contextConfig) versions() []version {
+	vv := []version{{c.major, c.minor}}
+	for _, v := range fallbacks[c.profile] {
+		if v.major < c.major || (v.major == c.major && v.minor < c.minor) {
+			vv = append(vv, v)
+		}
+	}
+	return vv
+}
+
+// attributes sets OpenGL attributes, keeping the first error.
+type attributes struct {
+	err error
+}
+
+func (a *attributes) set(attr sdl.GLAttr, value int32) {
+	err := sdl.GLSetAttribute(attr, value)
+	if err != nil && a.err == nil {
+		a.err = sdlError("SDL_GL_SetAttribute", err)
+	}
+}
+
+// setFramebufferAttributes sets the attributes of the default framebuffer.
+// They must be set before the creation of the window, as some platforms
+// select the pixel format at that time.
+func (w *Window) setFramebufferAttributes() error {
+	c := &w.glConfig
+	var a attributes
+
+	a.set(sdl.GLDoubleBuffer, 1)
+	a.set(sdl.GLDepthSize, c.depthBits)
+	a.set(sdl.GLStencilSize, c.stencilBits)
+	if c.multisample > 0 {
+		a.set(sdl.GLMultisampleBuffers, 1)
+		a.set(sdl.GLMultisampleSamples, c.multisample)
+	} else {
+		a.set(sdl.GLMultisampleBuffers, 0)
+		a.set(sdl.GLMultisampleSamples, 0)
+	}
+	a.set(sdl.GLFramebufferSRGBCapable, boolAttr(c.srgb))
+	return a.err
+}
+
+// createContext sets the context attributes, and creates the OpenGL context
+// of the window. If the requested version is not available, the lower
+// versions of the same profile are tried; if none is, a ContextError is
+// returned.
+func (w *Window) createContext() error {
+	c := &w.glConfig
+	var a attributes
+
+	flags := int32(0)
+	if w.debug {
+		flags |= sdl.GLContextDebugFlag
+	}
+	if c.forwardCompatible {
+		flags |= sdl.GLContextForwardCompatibleFlag
+	}
+	if c.robust {
+		flags |= sdl.GLContextRobustAccessFlag
+	}
+	a.set(sdl.GLContextFlags, flags)
+	a.set(sdl.GLContextProfileMask, int32(c.profile))
+	a.set(sdl.GLContextNoError, boolAttr(c.noError))
+
+	// The context to share objects with must be current
+	share := false
+	if c.share != nil && c.share.window != w.window && c.share.opened {
+		err := c.share.MakeCurrent()
+		if err != nil {
+			return err
+		}
+		share = true
+	}
+	a.set(sdl.GLShareWithCurrentContext, boolAttr(share))
+	if a.err != nil {
+		return a.err
+	}
+
+	var first error
+	for _, v := range c.versions() {
+		sdl.GLSetAttribute(sdl.GLContextMajorVersion, v.major)
+		sdl.GLSetAttribute(sdl.GLContextMinorVersion, v.minor)
+		ctx, err := sdl.GLCreateContext(w.handle)
+		if err == nil {
+			// The new context is current
+			w.context = ctx
+			current, currentThread = w.id, sdl.ThreadID()
+			return sdlError("SDL_GL_SetSwapInterval", w.setSwapInterval())
+		}
+		if first == nil {
+			first = sdlError("SDL_GL_CreateContext", err)
+		}
+	}
+
+	return &ContextError{
+		Requested: ContextVersion{c.profile, int(c.major), int(c.minor)},
+		Available: w.probeContext(),
+		Err:       first,
+	}
+}
+
+// probeContext returns the highest version that can be created with a profile
+// other than the requested one, or the zero value. The probe contexts are
+// deleted.
+func (w *Window) probeContext() ContextVersion {
+	current = 0
+	for _, p := range []Profile{GLCore, GLCompat, GLES} {
+		if p == w.glConfig.profile {
+			continue
+		}
+		sdl.GLSetAttribute(sdl.GLContextProfileMask, int32(p))
+		for _, v := range fallbacks[p] {
+			sdl.GLSetAttribute(sdl.GLContextMajorVersion, v.major)
+			sdl.GLSetAttribute(sdl.GLContextMinorVersion, v.minor)
+			ctx, err := sdl.GLCreateContext(w.handle)
+			if err == nil {
+				sdl.GLDeleteContext(ctx)
+				return ContextVersion{p, int(v.major), int(v.minor)}
+			}
+		}
+	}
+	return ContextVersion{}
+}
+
+// recreateContext replaces the OpenGL context of an opened window, to apply
+// new context attributes. The previous context is only deleted once the new
+// one is created; on failure, it is kept and made current again.
+func (w *Window) recreateContext() error {
+	old := w.context
+	err := w.createContext()
+	if err != nil {
+		w.context = old
+		current = 0
+		if e := w.MakeCurrent(); e != nil {
+			return fmt.Errorf("%w (%v)", err, e)
+		}
+		return err
+	}
+	sdl.GLDeleteContext(old)
+
+	// Function pointers may be specific to a context
+	err = setupGL(true)
+	if err != nil {
+		return err
+	}
+	w.setupDebug()
+	return nil
+}
+
+func boolAttr(b bool) int32 {
+	if b {
+		return 1
+	}
+	return 0
+}
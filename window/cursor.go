@@ -0,0 +1,132 @@
+package window
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+
+	"github.com/cozely/platform/internal/sdl"
+)
+
+// A Cursor is an image for the mouse pointer. Cursors are not tied to a
+// window: they can be used by all of them, and remain valid until freed. The
+// video subsystem is kept initialized while a cursor is not freed.
+type Cursor struct {
+	handle sdl.Cursor
+}
+
+// SystemCursor identifies one of the standard cursors of the system.
+type SystemCursor int32
+
+// Standard cursors.
+const (
+	CursorArrow      = SystemCursor(sdl.SystemCursorArrow)
+	CursorIBeam      = SystemCursor(sdl.SystemCursorIBeam)
+	CursorWait       = SystemCursor(sdl.SystemCursorWait)
+	CursorCrosshair  = SystemCursor(sdl.SystemCursorCrosshair)
+	CursorWaitArrow  = SystemCursor(sdl.SystemCursorWaitArrow) // small wait cursor, next to the arrow
+	CursorResizeNWSE = SystemCursor(sdl.SystemCursorSizeNWSE)
+	CursorResizeNESW = SystemCursor(sdl.SystemCursorSizeNESW)
+	CursorResizeWE   = SystemCursor(sdl.SystemCursorSizeWE)
+	CursorResizeNS   = SystemCursor(sdl.SystemCursorSizeNS)
+	CursorResizeAll  = SystemCursor(sdl.SystemCursorSizeAll)
+	CursorHand       = SystemCursor(sdl.SystemCursorHand)
+	CursorNo         = SystemCursor(sdl.SystemCursorNo) // action not allowed
+)
+
+// NewCursor creates a color cursor from an image. The hotspot is the pixel,
+// relative to the top left of the image, that designates the position of the
+// mouse.
+func NewCursor(img image.Image, hotspot Coord) (*Cursor, error) {
+	checkThread("window.NewCursor")
+	b := img.Bounds()
+	if b.Empty() {
+		return nil, errors.New("window.NewCursor: empty image")
+	}
+	if !(image.Point{int(hotspot.X), int(hotspot.Y)}).In(image.Rect(0, 0, b.Dx(), b.Dy())) {
+		return nil, fmt.Errorf("window.NewCursor: hotspot %v outside of the image", hotspot)
+	}
+	err := acquireVideo("")
+	if err != nil {
+		return nil, fmt.Errorf("window.NewCursor: %w", err)
+	}
+
+	// SDL expects non-premultiplied alpha
+	m, ok := img.(*image.NRGBA)
+	if !ok || b.Min != (image.Point{}) {
+		m = image.NewNRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+		draw.Draw(m, m.Bounds(), img, b.Min, draw.Src)
+	}
+
+	h, err := sdl.CreateColorCursor(m.Pix, int32(b.Dx()), int32(b.Dy()), int32(m.Stride), hotspot.X, hotspot.Y)
+	if err != nil {
+		releaseVideo()
+		return nil, fmt.Errorf("window.NewCursor: %w", sdlError("SDL_CreateColorCursor", err))
+	}
+	return &Cursor{handle: h}, nil
+}
+
+// NewSystemCursor creates one of the standard cursors of the system.
+func NewSystemCursor(id SystemCursor) (*Cursor, error) {
+	checkThread("window.NewSystemCursor")
+	err := acquireVideo("")
+	if err != nil {
+		return nil, fmt.Errorf("window.NewSystemCursor: %w", err)
+	}
+
+	h, err := sdl.CreateSystemCursor(sdl.SystemCursor(id))
+	if err != nil {
+		releaseVideo()
+		return nil, fmt.Errorf("window.NewSystemCursor: %w", sdlError("SDL_CreateSystemCursor", err))
+	}
+	return &Cursor{handle: h}, nil
+}
+
+// Free releases the cursor. If it is the active cursor, the default one is
+// restored. Freeing a cursor more than once has no effect.
+func (c *Cursor) Free() {
+	checkThread("window.Cursor.Free")
+	if c.handle == (sdl.Cursor{}) {
+		return
+	}
+	sdl.FreeCursor(c.handle)
+	c.handle = sdl.Cursor{}
+	releaseVideo()
+}
+
+// SetCursor changes the cursor shown over all windows. If c is nil, the
+// default cursor is restored.
+func SetCursor(c *Cursor) error {
+	checkThread("window.SetCursor")
+	if c == nil {
+		if sdl.WasInit(sdl.InitVideo) != 0 {
+			sdl.SetCursor(sdl.GetDefaultCursor())
+		}
+		return nil
+	}
+	if c.handle == (sdl.Cursor{}) {
+		return errors.New("window.SetCursor: cursor has been freed")
+	}
+	sdl.SetCursor(c.handle)
+	return nil
+}
+
+// ShowCursor shows or hides the cursor over all windows.
+func ShowCursor(show bool) error {
+	checkThread("window.ShowCursor")
+	toggle := int32(0)
+	if show {
+		toggle = 1
+	}
+	err := acquireVideo("")
+	if err == nil {
+		_, err = sdl.ShowCursor(toggle)
+		err = sdlError("SDL_ShowCursor", err)
+		releaseVideo()
+	}
+	if err != nil {
+		return fmt.Errorf("window.ShowCursor: %w", err)
+	}
+	return nil
+}
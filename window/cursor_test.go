@@ -0,0 +1,56 @@
+package window
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestNewCursorArguments(t *testing.T) {
+	if _, err := NewCursor(image.NewNRGBA(image.Rect(0, 0, 0, 0)), Coord{}); err == nil {
+		t.Errorf("NewCursor with an empty image did not fail")
+	}
+	m := image.NewNRGBA(image.Rect(10, 10, 26, 26))
+	if _, err := NewCursor(m, Coord{16, 0}); err == nil {
+		t.Errorf("NewCursor with the hotspot outside of the image did not fail")
+	}
+}
+
+func TestCursor(t *testing.T) {
+	w := newHeadless(t)
+	defer closeHeadless(w)
+
+	m := image.NewRGBA(image.Rect(0, 0, 16, 16))
+	for i := 0; i < 16; i++ {
+		m.Set(i, i, color.RGBA{255, 255, 255, 255})
+	}
+	c, err := NewCursor(m, Coord{0, 0})
+	if err != nil {
+		// The offscreen driver may not support cursors
+		t.Skipf("NewCursor: %v", err)
+	}
+	if err := SetCursor(c); err != nil {
+		t.Error(err)
+	}
+
+	s, err := NewSystemCursor(CursorIBeam)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := SetCursor(s); err != nil {
+		t.Error(err)
+	}
+	s.Free()
+
+	c.Free()
+	c.Free()
+	if err := SetCursor(c); err == nil {
+		t.Errorf("SetCursor with a freed cursor did not fail")
+	}
+	if err := SetCursor(nil); err != nil {
+		t.Error(err)
+	}
+	if videoUsers != 1 {
+		t.Errorf("%d users of the video subsystem, want 1 (the window)", videoUsers)
+	}
+}
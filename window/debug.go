@@ -0,0 +1,177 @@
+package window
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/cozely/platform/internal/gl"
+)
+
+// A DebugMessage is a message of the OpenGL debug output.
+type DebugMessage struct {
+	WindowID uint32 // 0 if the window is not yet (or no longer) opened
+	Source   DebugSource
+	Type     DebugType
+	ID       uint32
+	Severity Severity
+	Message  string
+}
+
+func (m DebugMessage) String() string {
+	return fmt.Sprintf("OpenGL %v: %v %v (%d): %s", m.Severity, m.Source, m.Type, m.ID, m.Message)
+}
+
+// DebugSource identifies the origin of a debug message.
+type DebugSource uint32
+
+// Sources of debug messages.
+const (
+	SourceAPI            = DebugSource(gl.DEBUG_SOURCE_API)
+	SourceWindowSystem   = DebugSource(gl.DEBUG_SOURCE_WINDOW_SYSTEM)
+	SourceShaderCompiler = DebugSource(gl.DEBUG_SOURCE_SHADER_COMPILER)
+	SourceThirdParty     = DebugSource(gl.DEBUG_SOURCE_THIRD_PARTY)
+	SourceApplication    = DebugSource(gl.DEBUG_SOURCE_APPLICATION)
+	SourceOther          = DebugSource(gl.DEBUG_SOURCE_OTHER)
+)
+
+func (s DebugSource) String() string {
+	switch s {
+	case SourceAPI:
+		return "API"
+	case SourceWindowSystem:
+		return "window system"
+	case SourceShaderCompiler:
+		return "shader compiler"
+	case SourceThirdParty:
+		return "third party"
+	case SourceApplication:
+		return "application"
+	}
+	return "other"
+}
+
+// DebugType identifies the kind of a debug message.
+type DebugType uint32
+
+// Types of debug messages.
+const (
+	TypeError              = DebugType(gl.DEBUG_TYPE_ERROR)
+	TypeDeprecatedBehavior = DebugType(gl.DEBUG_TYPE_DEPRECATED_BEHAVIOR)
+	TypeUndefinedBehavior  = DebugType(gl.DEBUG_TYPE_UNDEFINED_BEHAVIOR)
+	TypePortability        = DebugType(gl.DEBUG_TYPE_PORTABILITY)
+	TypePerformance        = DebugType(gl.DEBUG_TYPE_PERFORMANCE)
+	TypeMarker             = DebugType(gl.DEBUG_TYPE_MARKER)
+	TypePushGroup          = DebugType(gl.DEBUG_TYPE_PUSH_GROUP)
+	TypePopGroup           = DebugType(gl.DEBUG_TYPE_POP_GROUP)
+	TypeOther              = DebugType(gl.DEBUG_TYPE_OTHER)
+)
+
+func (t DebugType) String() string {
+	switch t {
+	case TypeError:
+		return "error"
+	case TypeDeprecatedBehavior:
+		return "deprecated behavior"
+	case TypeUndefinedBehavior:
+		return "undefined behavior"
+	case TypePortability:
+		return "portability"
+	case TypePerformance:
+		return "performance"
+	case TypeMarker:
+		return "marker"
+	case TypePushGroup:
+		return "push group"
+	case TypePopGroup:
+		return "pop group"
+	}
+	return "other"
+}
+
+// Severity is the importance of a debug message. Severities are ordered, from
+// SeverityNotification to SeverityHigh.
+type Severity int
+
+// Severities of debug messages.
+const (
+	SeverityNotification Severity = iota
+	SeverityLow
+	SeverityMedium
+	SeverityHigh
+)
+
+var severities = [...]gl.Enum{
+	SeverityNotification: gl.DEBUG_SEVERITY_NOTIFICATION,
+	SeverityLow:          gl.DEBUG_SEVERITY_LOW,
+	SeverityMedium:       gl.DEBUG_SEVERITY_MEDIUM,
+	SeverityHigh:         gl.DEBUG_SEVERITY_HIGH,
+}
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityNotification:
+		return "notification"
+	case SeverityLow:
+		return "low"
+	case SeverityMedium:
+		return "medium"
+	case SeverityHigh:
+		return "high"
+	}
+	return "(unknown)"
+}
+
+// LogDebugMessage is the default debug handler: it prints the message with
+// the log package.
+func LogDebugMessage(m DebugMessage) {
+	log.Print(m)
+}
+
+// setupDebug installs the debug output callback in the context of the window,
+// which must be current. It does nothing if the window is not in debug mode,
+// or if the context does not support KHR_debug.
+func (w *Window) setupDebug() {
+	if !w.debug {
+		return
+	}
+
+	err := gl.DebugMessageCallback(dispatchDebug, uintptr(w.id))
+	if err != nil {
+		return
+	}
+	gl.Enable(gl.DEBUG_OUTPUT)
+	// Synchronous output makes the stack trace useful when panicking
+	gl.Enable(gl.DEBUG_OUTPUT_SYNCHRONOUS)
+
+	for s, e := range severities {
+		gl.DebugMessageControl(gl.DONT_CARE, gl.DONT_CARE, e, 0, nil, Severity(s) >= w.debugFilter)
+	}
+}
+
+func dispatchDebug(source, typ gl.Enum, id uint32, severity gl.Enum, message string, userParam uintptr) {
+	w := lookup(uint32(userParam))
+	m := DebugMessage{
+		Source:  DebugSource(source),
+		Type:    DebugType(typ),
+		ID:      id,
+		Message: message,
+	}
+	for s, e := range severities {
+		if e == severity {
+			m.Severity = Severity(s)
+		}
+	}
+
+	if w == nil {
+		LogDebugMessage(m)
+		return
+	}
+	m.WindowID = w.id
+	if m.Severity < w.debugFilter {
+		return
+	}
+	w.debugHandler(m)
+	if w.debugPanic && m.Severity == SeverityHigh {
+		panic(m.String())
+	}
+}
@@ -0,0 +1,5 @@
+// +build !debug
+
+package window
+
+const debugPanicDefault = false
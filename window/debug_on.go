@@ -0,0 +1,7 @@
+// +build debug
+
+package window
+
+// In development builds (with the "debug" build tag), high severity debug
+// messages panic by default.
+const debugPanicDefault = true
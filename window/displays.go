@@ -0,0 +1,150 @@
+package window
+
+import (
+	"fmt"
+
+	"github.com/cozely/platform/internal/sdl"
+)
+
+// A Display describes a monitor connected to the system.
+type Display struct {
+	Index  int    // the index to use with the Monitor option
+	Name   string // may be empty
+	Bounds Rect   // the area of the display on the desktop
+	Usable Rect   // the area not used by the system (e.g. taskbars and menus)
+
+	// The dots per inch of the display: diagonal, horizontal and vertical. They
+	// are zero if unknown.
+	DDPI, HDPI, VDPI float32
+
+	Desktop DisplayMode   // the mode of the display on the desktop
+	Modes   []DisplayMode // all available modes, sorted from the largest
+}
+
+// A Rect is an area of the desktop, in screen coordinates.
+type Rect struct {
+	Position Coord
+	Size     Coord
+}
+
+// A DisplayMode describes a resolution, refresh rate and pixel format
+// supported by a display.
+type DisplayMode struct {
+	Display     int // index of the display the mode belongs to
+	Size        Coord
+	RefreshRate int32 // in Hz, zero if unspecified
+	Format      PixelFormat
+}
+
+// PixelFormat identifies the pixel format of a display mode.
+type PixelFormat uint32
+
+// String returns the name of the pixel format (e.g. "SDL_PIXELFORMAT_RGB888").
+func (f PixelFormat) String() string {
+	return sdl.GetPixelFormatName(uint32(f))
+}
+
+// Displays returns the list of all displays connected to the system.
+func Displays() ([]Display, error) {
+	checkThread("window.Displays")
+
+	err := acquireVideo("")
+	if err != nil {
+		return nil, fmt.Errorf("window.Displays: %w", err)
+	}
+	defer releaseVideo()
+
+	n, err := sdl.GetNumVideoDisplays()
+	if err != nil {
+		err = &SDLError{Call: "SDL_GetNumVideoDisplays", Message: err.Error(), Err: ErrNoDisplay}
+		return nil, fmt.Errorf("window.Displays: %w", err)
+	}
+	if n < 1 {
+		return nil, fmt.Errorf("window.Displays: %w", ErrNoDisplay)
+	}
+
+	dd := make([]Display, n)
+	for i := range dd {
+		err := dd[i].query(int32(i))
+		if err != nil {
+			return nil, fmt.Errorf("window.Displays: display %d: %w", i, err)
+		}
+	}
+	return dd, nil
+}
+
+func (d *Display) query(i int32) error {
+	var err error
+
+	d.Index = int(i)
+
+	d.Name, err = sdl.GetDisplayName(i)
+	if err != nil {
+		return sdlError("SDL_GetDisplayName", err)
+	}
+
+	var r sdl.Rect
+	err = sdl.GetDisplayBounds(i, &r)
+	if err != nil {
+		return sdlError("SDL_GetDisplayBounds", err)
+	}
+	d.Bounds = Rect{Coord{r.X, r.Y}, Coord{r.W, r.H}}
+
+	err = sdl.GetDisplayUsableBounds(i, &r)
+	if err != nil {
+		d.Usable = d.Bounds
+	} else {
+		d.Usable = Rect{Coord{r.X, r.Y}, Coord{r.W, r.H}}
+	}
+
+	err = sdl.GetDisplayDPI(i, &d.DDPI, &d.HDPI, &d.VDPI)
+	if err != nil {
+		// Not all platforms can report the DPI
+		d.DDPI, d.HDPI, d.VDPI = 0, 0, 0
+	}
+
+	var m sdl.DisplayMode
+	err = sdl.GetDesktopDisplayMode(i, &m)
+	if err != nil {
+		return sdlError("SDL_GetDesktopDisplayMode", err)
+	}
+	d.Desktop = newDisplayMode(i, m)
+
+	n, err := sdl.GetNumDisplayModes(i)
+	if err != nil {
+		return sdlError("SDL_GetNumDisplayModes", err)
+	}
+	d.Modes = make([]DisplayMode, 0, n)
+	for j := int32(0); j < n; j++ {
+		err = sdl.GetDisplayMode(i, j, &m)
+		if err != nil {
+			return sdlError("SDL_GetDisplayMode", err)
+		}
+		d.Modes = append(d.Modes, newDisplayMode(i, m))
+	}
+
+	return nil
+}
+
+func newDisplayMode(display int32, m sdl.DisplayMode) DisplayMode {
+	return DisplayMode{
+		Display:     int(display),
+		Size:        Coord{m.W, m.H},
+		RefreshRate: m.RefreshRate,
+		Format:      PixelFormat(m.Format),
+	}
+}
+
+// String returns a short description of the mode (e.g. "1920x1080@60Hz").
+func (m DisplayMode) String() string {
+	return fmt.Sprintf("%dx%d@%dHz", m.Size.X, m.Size.Y, m.RefreshRate)
+}
+
+func (m DisplayMode) toSDL() sdl.DisplayMode {
+	return sdl.DisplayMode{
+		Format:      uint32(m.Format),
+		W:           m.Size.X,
+		H:           m.Size.Y,
+		RefreshRate: m.RefreshRate,
+	}
+}
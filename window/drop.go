@@ -0,0 +1,70 @@
+package window
+
+import (
+	"github.com/cozely/platform/internal/sdl"
+)
+
+// drops holds the drops in progress, by window ID.
+var drops = map[uint32]*Dropped{}
+
+// enableDrop enables the drag and drop events, some of which are disabled by
+// default in SDL.
+func enableDrop() {
+	sdl.EventState(sdl.EventDropBegin, sdl.Enable)
+	sdl.EventState(sdl.EventDropFile, sdl.Enable)
+	sdl.EventState(sdl.EventDropText, sdl.Enable)
+	sdl.EventState(sdl.EventDropComplete, sdl.Enable)
+}
+
+// translateDrop collects the files and text of a drop, and returns a Dropped
+// event when it is complete.
+func translateDrop(e *sdl.Event) Event {
+	d := e.Drop()
+	switch e.Type {
+	case sdl.EventDropBegin:
+		drops[d.WindowID] = &Dropped{WindowID: d.WindowID}
+		return DropBegin{WindowID: d.WindowID}
+
+	case sdl.EventDropFile, sdl.EventDropText:
+		s := sdl.TakeDropFile(d)
+		p, ok := drops[d.WindowID]
+		if !ok {
+			// Versions of SDL before 2.0.5 do not send DropBegin and
+			// DropComplete: each item is a drop of its own
+			p = &Dropped{WindowID: d.WindowID}
+		}
+		if e.Type == sdl.EventDropFile {
+			p.Files = append(p.Files, s)
+		} else if p.Text == "" {
+			p.Text = s
+		} else {
+			p.Text += "\n" + s
+		}
+		if !ok {
+			return p.complete()
+		}
+
+	case sdl.EventDropComplete:
+		p, ok := drops[d.WindowID]
+		if !ok {
+			return nil
+		}
+		delete(drops, d.WindowID)
+		return p.complete()
+	}
+	return nil
+}
+
+// complete sets the position of the drop, and returns the event.
+func (p *Dropped) complete() Event {
+	w := lookup(p.WindowID)
+	if w != nil {
+		// SDL does not report the position of the drop, but the mouse is still
+		// over it
+		var x, y int32
+		sdl.GetGlobalMouseState(&x, &y)
+		wx, wy := sdl.GetWindowPosition(w.handle)
+		p.Position = Coord{x - wx, y - wy}
+	}
+	return *p
+}
@@ -0,0 +1,44 @@
+package window
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cozely/platform/internal/sdl"
+)
+
+func TestTranslateDrop(t *testing.T) {
+	drop := func(typ sdl.EventType, id uint32) Event {
+		e := sdl.Event{Type: typ}
+		e.Drop().WindowID = id
+		return translate(&e)
+	}
+
+	// The window IDs are not registered, so no SDL call is made
+	if ev := drop(sdl.EventDropBegin, 42); ev != (DropBegin{WindowID: 42}) {
+		t.Errorf("DropBegin: got %#v", ev)
+	}
+	for i := 0; i < 3; i++ {
+		if ev := drop(sdl.EventDropFile, 42); ev != nil {
+			t.Errorf("DropFile in a batch: got %#v, want nil", ev)
+		}
+	}
+	ev := drop(sdl.EventDropComplete, 42)
+	want := Dropped{WindowID: 42, Files: []string{"", "", ""}}
+	if !reflect.DeepEqual(ev, want) {
+		t.Errorf("DropComplete: got %#v, want %#v", ev, want)
+	}
+	if len(drops) != 0 {
+		t.Errorf("%d drops still in progress", len(drops))
+	}
+
+	// Without DropBegin, each item is a drop of its own
+	ev = drop(sdl.EventDropFile, 7)
+	want = Dropped{WindowID: 7, Files: []string{""}}
+	if !reflect.DeepEqual(ev, want) {
+		t.Errorf("DropFile without DropBegin: got %#v, want %#v", ev, want)
+	}
+	if ev := drop(sdl.EventDropComplete, 7); ev != nil {
+		t.Errorf("unexpected DropComplete: got %#v, want nil", ev)
+	}
+}
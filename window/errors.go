@@ -0,0 +1,84 @@
+package window
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrClosed is returned by the methods of a window that has been closed.
+var ErrClosed = errors.New("window closed")
+
+// ErrNotSupported is returned when a feature is not available on the current
+// platform, or with the installed version of SDL.
+var ErrNotSupported = errors.New("not supported")
+
+// ErrNoDisplay is returned when the video subsystem cannot be initialized, or
+// reports no display (e.g. when running without a graphical session). The
+// Headless option may then be used instead.
+var ErrNoDisplay = errors.New("no display available")
+
+// ErrContextCreation is matched, with errors.Is, by the ContextError returned
+// when no OpenGL context can be created.
+var ErrContextCreation = errors.New("OpenGL context creation failed")
+
+// An SDLError is a failure reported by SDL.
+type SDLError struct {
+	Call    string // name of the SDL function that failed
+	Message string // as returned by SDL_GetError
+	Err     error  // corresponding error of this package (e.g. ErrNoDisplay), or nil
+}
+
+func (e *SDLError) Error() string {
+	return e.Call + ": " + e.Message
+}
+
+// Unwrap returns e.Err.
+func (e *SDLError) Unwrap() error {
+	return e.Err
+}
+
+// sdlError wraps an error returned by the SDL function call, or returns nil.
+func sdlError(call string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &SDLError{Call: call, Message: err.Error()}
+}
+
+// A ContextVersion identifies the profile and version of an OpenGL context.
+type ContextVersion struct {
+	Profile      Profile
+	Major, Minor int
+}
+
+func (v ContextVersion) String() string {
+	return fmt.Sprintf("OpenGL %v %d.%d", v.Profile, v.Major, v.Minor)
+}
+
+// A ContextError is returned when no OpenGL context can be created with the
+// requested profile, even with the lower versions. Available is then the
+// highest version found with another profile, which the application may
+// request instead; it is the zero value if no context can be created at all.
+type ContextError struct {
+	Requested ContextVersion
+	Available ContextVersion
+	Err       error // failure of the requested version
+}
+
+func (e *ContextError) Error() string {
+	s := "unable to create " + e.Requested.String() + " context"
+	if e.Available != (ContextVersion{}) {
+		s += " (" + e.Available.String() + " available)"
+	}
+	return s + ": " + e.Err.Error()
+}
+
+// Unwrap returns e.Err.
+func (e *ContextError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is ErrContextCreation.
+func (e *ContextError) Is(target error) bool {
+	return target == ErrContextCreation
+}
@@ -0,0 +1,43 @@
+package window
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestErrors(t *testing.T) {
+	err := fmt.Errorf("window.New: %w", &ContextError{
+		Requested: ContextVersion{GLCore, 4, 6},
+		Available: ContextVersion{GLES, 3, 2},
+		Err:       sdlError("SDL_GL_CreateContext", errors.New("GLXBadFBConfig")),
+	})
+
+	if !errors.Is(err, ErrContextCreation) {
+		t.Errorf("errors.Is(%v, ErrContextCreation) = false", err)
+	}
+	var c *ContextError
+	if !errors.As(err, &c) || c.Available != (ContextVersion{GLES, 3, 2}) {
+		t.Errorf("errors.As(%v, *ContextError) did not return the available version", err)
+	}
+	var s *SDLError
+	if !errors.As(err, &s) || s.Call != "SDL_GL_CreateContext" {
+		t.Errorf("errors.As(%v, *SDLError) did not return the failed call", err)
+	}
+	want := "window.New: unable to create OpenGL Core 4.6 context (OpenGL ES 3.2 available): SDL_GL_CreateContext: GLXBadFBConfig"
+	if err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+
+	err = fmt.Errorf("window.Displays: %w", &SDLError{Call: "SDL_Init", Message: "No available video device", Err: ErrNoDisplay})
+	if !errors.Is(err, ErrNoDisplay) {
+		t.Errorf("errors.Is(%v, ErrNoDisplay) = false", err)
+	}
+	if errors.Is(err, ErrContextCreation) {
+		t.Errorf("errors.Is(%v, ErrContextCreation) = true", err)
+	}
+
+	if sdlError("SDL_Init", nil) != nil {
+		t.Errorf("sdlError with a nil error is not nil")
+	}
+}
@@ -0,0 +1,197 @@
+package window
+
+import (
+	"github.com/cozely/platform/internal/events"
+	"github.com/cozely/platform/internal/sdl"
+)
+
+// An Event is a notification from the system, collected by PollEvents. Use a
+// type switch to find its kind. The events concerning a window carry its ID
+// (see Window.ID and Lookup).
+type Event interface{}
+
+// Quit is sent when the user requests to quit the application (e.g. by
+// closing the last window, or with a system shortcut).
+type Quit struct{}
+
+// Shown is sent when a window becomes visible.
+type Shown struct {
+	WindowID uint32
+}
+
+// Hidden is sent when a window is hidden.
+type Hidden struct {
+	WindowID uint32
+}
+
+// Resized is sent when the size of a window changes, either on user request or
+// programmatically.
+type Resized struct {
+	WindowID uint32
+	Size     Coord
+}
+
+// Moved is sent when a window is moved to a new position on the desktop.
+type Moved struct {
+	WindowID uint32
+	Position Coord
+}
+
+// FocusGained is sent when a window gains keyboard focus.
+type FocusGained struct {
+	WindowID uint32
+}
+
+// FocusLost is sent when a window loses keyboard focus.
+type FocusLost struct {
+	WindowID uint32
+}
+
+// MouseEntered is sent when the mouse enters a window.
+type MouseEntered struct {
+	WindowID uint32
+}
+
+// MouseLeft is sent when the mouse leaves a window.
+type MouseLeft struct {
+	WindowID uint32
+}
+
+// CloseRequested is sent when the window manager asks for a window to be
+// closed. The window is not closed automatically.
+type CloseRequested struct {
+	WindowID uint32
+}
+
+// TextInput is sent when text is entered, while text input is enabled (see
+// StartTextInput). The text is in UTF-8, and may contain several characters.
+type TextInput struct {
+	WindowID uint32
+	Text     string
+}
+
+// TextEditing is sent when the composition text of an input method changes
+// (e.g. for CJK languages), while text input is enabled. The text is not part
+// of the input until a TextInput event is sent. Cursor is the position of the
+// cursor in the composition text, and Selection the length of the selected
+// part after it, both in characters (runes).
+type TextEditing struct {
+	WindowID  uint32
+	Text      string
+	Cursor    int
+	Selection int
+}
+
+// DropBegin is sent when files or text start being dropped onto a window.
+type DropBegin struct {
+	WindowID uint32
+}
+
+// Dropped is sent when files or text have been dropped onto a window. The
+// files dropped together are grouped in a single event, and the texts are
+// joined by newlines. Position is the position of the mouse in the window
+// when the drop is complete; it is approximate, since not all platforms can
+// report it during a drag and drop.
+type Dropped struct {
+	WindowID uint32
+	Files    []string
+	Text     string
+	Position Coord
+}
+
+// ScaleChanged is sent when the content scale of a window changes, e.g. when
+// it is moved to a display with a different pixel density (see HighDPI).
+type ScaleChanged struct {
+	WindowID uint32
+	Scale    float32
+}
+
+var polled []Event
+
+// PollEvents processes all pending system events. It updates the state of the
+// windows, and collects the events so that they can be retrieved with Events.
+// It should be called once per frame, from the thread that created the
+// windows.
+func PollEvents() {
+	checkThread("window.PollEvents")
+	closeLeaked()
+
+	polled = polled[:0]
+
+	var e sdl.Event
+	for sdl.PollEvent(&e) {
+		ev := translate(&e)
+		if ev != nil {
+			polled = append(polled, ev)
+		}
+	}
+
+	for _, w := range opened() {
+		if (&Window{w}).checkScale() {
+			polled = append(polled, ScaleChanged{WindowID: w.id, Scale: w.scale})
+		}
+	}
+}
+
+// Events returns the events collected by the last call to PollEvents, in the
+// order they were received. The slice is only valid until the next call to
+// PollEvents.
+func Events() []Event {
+	return polled
+}
+
+// translate updates the window state according to e, and returns the
+// corresponding event, or nil if there is none. Events not handled by this
+// package are passed to the translators registered by the other platform
+// packages.
+func translate(e *sdl.Event) Event {
+	switch e.Type {
+	case sdl.EventQuit:
+		return Quit{}
+	case sdl.EventWindow:
+		return translateWindow(e.Window())
+	case sdl.EventMouseMotion, sdl.EventMouseButtonDown, sdl.EventMouseButtonUp, sdl.EventMouseWheel:
+		return translateMouse(e)
+	case sdl.EventTextInput, sdl.EventTextEditing:
+		return translateText(e)
+	case sdl.EventDropBegin, sdl.EventDropFile, sdl.EventDropText, sdl.EventDropComplete:
+		return translateDrop(e)
+	}
+	return events.Translate(e)
+}
+
+func translateWindow(e *sdl.WindowEvent) Event {
+	w := lookup(e.WindowID)
+	if w == nil {
+		return nil
+	}
+
+	switch e.Event {
+	case sdl.WindowEventShown:
+		return Shown{WindowID: e.WindowID}
+	case sdl.WindowEventHidden:
+		return Hidden{WindowID: e.WindowID}
+	case sdl.WindowEventSizeChanged:
+		w.size = Coord{e.Data1, e.Data2}
+		return Resized{WindowID: e.WindowID, Size: w.size}
+	case sdl.WindowEventMoved:
+		// The window may have changed display
+		w.updateRefresh()
+		return Moved{WindowID: e.WindowID, Position: Coord{e.Data1, e.Data2}}
+	case sdl.WindowEventFocusGained:
+		w.hasFocus = true
+		return FocusGained{WindowID: e.WindowID}
+	case sdl.WindowEventFocusLost:
+		w.hasFocus = false
+		return FocusLost{WindowID: e.WindowID}
+	case sdl.WindowEventEnter:
+		w.hasMouseFocus = true
+		return MouseEntered{WindowID: e.WindowID}
+	case sdl.WindowEventLeave:
+		w.hasMouseFocus = false
+		return MouseLeft{WindowID: e.WindowID}
+	case sdl.WindowEventClose:
+		return CloseRequested{WindowID: e.WindowID}
+	}
+	return nil
+}
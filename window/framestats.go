@@ -0,0 +1,166 @@
+package window
+
+import (
+	"sort"
+	"time"
+
+	"github.com/cozely/platform/internal/sdl"
+)
+
+// frameHistory is the number of recent frames used for the statistics.
+const frameHistory = 120
+
+// FrameStats describes the duration of the frames, measured between
+// successive calls to Present. Apart from the counters, the statistics are
+// computed over the last 120 frames.
+type FrameStats struct {
+	Frames  uint64 // number of frames presented
+	Dropped uint64 // number of frames that took longer than expected
+
+	Last    time.Duration
+	Average time.Duration
+	Min     time.Duration
+	Max     time.Duration
+	P50     time.Duration // median
+	P95     time.Duration
+	P99     time.Duration
+
+	FPS float64 // achieved frames per second
+}
+
+// frameTimer measures the frames with the performance counter of SDL, and
+// implements the frame limiter.
+type frameTimer struct {
+	freq    uint64
+	prev    uint64
+	times   [frameHistory]time.Duration
+	n, next int
+	count   uint64
+	dropped uint64
+
+	limit    time.Duration // interval of the frame limiter, or zero
+	deadline uint64        // counter value at which the next frame may start
+	refresh  time.Duration // refresh period of the display, or zero if unknown
+}
+
+// FrameStats returns the statistics of the frames presented so far.
+func (w *Window) FrameStats() FrameStats {
+	t := &w.frames
+	s := FrameStats{
+		Frames:  t.count,
+		Dropped: t.dropped,
+	}
+	if t.n == 0 {
+		return s
+	}
+
+	d := make([]time.Duration, t.n)
+	copy(d, t.times[:t.n])
+	sort.Slice(d, func(i, j int) bool { return d[i] < d[j] })
+
+	var sum time.Duration
+	for _, x := range d {
+		sum += x
+	}
+	s.Last = t.times[(t.next+frameHistory-1)%frameHistory]
+	s.Average = sum / time.Duration(t.n)
+	s.Min = d[0]
+	s.Max = d[t.n-1]
+	s.P50 = percentile(d, 50)
+	s.P95 = percentile(d, 95)
+	s.P99 = percentile(d, 99)
+	if sum > 0 {
+		s.FPS = float64(t.n) / sum.Seconds()
+	}
+	return s
+}
+
+// percentile returns the p-th percentile of the sorted durations d (nearest
+// rank method).
+func percentile(d []time.Duration, p int) time.Duration {
+	i := (len(d)*p + 99) / 100
+	if i < 1 {
+		i = 1
+	}
+	return d[i-1]
+}
+
+// expectedFrame returns the expected duration of a frame, or zero if unknown:
+// the interval of the frame limiter, or the refresh period of the display when
+// synchronized with it.
+func (w *Window) expectedFrame() time.Duration {
+	if w.frames.limit > 0 {
+		return w.frames.limit
+	}
+	if w.vsync {
+		return w.frames.refresh
+	}
+	return 0
+}
+
+// updateRefresh reads the refresh rate of the display the window is on.
+func (w *window) updateRefresh() {
+	w.frames.refresh = 0
+	i, err := sdl.GetWindowDisplayIndex(w.handle)
+	if err != nil {
+		return
+	}
+	var m sdl.DisplayMode
+	err = sdl.GetDesktopDisplayMode(i, &m)
+	if err != nil || m.RefreshRate <= 0 {
+		return
+	}
+	w.frames.refresh = time.Second / time.Duration(m.RefreshRate)
+}
+
+// endFrame is called by Present after the buffers are swapped: it waits for
+// the frame limiter, and records the duration of the frame.
+func (w *Window) endFrame() {
+	t := &w.frames
+	if t.freq == 0 {
+		t.freq = sdl.GetPerformanceFrequency()
+	}
+
+	now := sdl.GetPerformanceCounter()
+	if t.limit > 0 {
+		if now < t.deadline {
+			time.Sleep(t.duration(t.deadline - now))
+			now = sdl.GetPerformanceCounter()
+		}
+		t.advanceDeadline(now)
+	}
+
+	if t.prev != 0 {
+		d := t.duration(now - t.prev)
+		t.times[t.next] = d
+		t.next = (t.next + 1) % frameHistory
+		if t.n < frameHistory {
+			t.n++
+		}
+		if e := w.expectedFrame(); e > 0 && d > e+e/2 {
+			t.dropped++
+		}
+	}
+	t.prev = now
+	t.count++
+}
+
+// advanceDeadline sets the deadline of the next frame. It is relative to the
+// previous one, to keep a steady rate, except on the first frame or when the
+// frame was late: it is then one interval after now.
+func (t *frameTimer) advanceDeadline(now uint64) {
+	d := t.ticks(t.limit)
+	if t.deadline == 0 || t.deadline+d < now {
+		t.deadline = now + d
+		return
+	}
+	t.deadline += d
+}
+
+func (t *frameTimer) duration(ticks uint64) time.Duration {
+	return time.Duration(float64(ticks) * float64(time.Second) / float64(t.freq))
+}
+
+func (t *frameTimer) ticks(d time.Duration) uint64 {
+	return uint64(d.Seconds() * float64(t.freq))
+}
@@ -0,0 +1,82 @@
+package window
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFrameStats(t *testing.T) {
+	w := Window{&window{}}
+	if s := w.FrameStats(); s.Frames != 0 || s.FPS != 0 {
+		t.Errorf("stats before any frame = %+v", s)
+	}
+
+	// 100 frames of 1 to 100ms, the last one being 50ms
+	for i := 1; i <= 100; i++ {
+		d := time.Duration(i) * time.Millisecond
+		if i == 50 {
+			d = 100 * time.Millisecond
+		} else if i == 100 {
+			d = 50 * time.Millisecond
+		}
+		w.frames.times[w.frames.next] = d
+		w.frames.next++
+		w.frames.n++
+		w.frames.count++
+	}
+
+	s := w.FrameStats()
+	ms := time.Millisecond
+	want := FrameStats{
+		Frames:  100,
+		Last:    50 * ms,
+		Average: 50500 * time.Microsecond,
+		Min:     1 * ms,
+		Max:     100 * ms,
+		P50:     50 * ms,
+		P95:     95 * ms,
+		P99:     99 * ms,
+	}
+	s.FPS, want.FPS = 0, 0
+	if s != want {
+		t.Errorf("FrameStats() = %+v\nwant %+v", s, want)
+	}
+}
+
+func TestFrameLimit(t *testing.T) {
+	w := newHeadless(t, VSync(false), FrameLimit(50))
+	defer closeHeadless(w)
+
+	for i := 0; i < 6; i++ {
+		w.Present()
+	}
+
+	s := w.FrameStats()
+	if s.Frames != 6 {
+		t.Errorf("%d frames counted, want 6", s.Frames)
+	}
+	if s.Min < 15*time.Millisecond {
+		t.Errorf("shortest frame is %v with a limit of 50 fps", s.Min)
+	}
+}
+
+func TestAdvanceDeadline(t *testing.T) {
+	// One tick per millisecond, 20ms per frame
+	f := frameTimer{freq: 1000, limit: 20 * time.Millisecond}
+
+	cases := []struct {
+		now, deadline uint64
+	}{
+		{1000, 1020}, // first frame
+		{1020, 1040}, // on time
+		{1045, 1060}, // late, but within an interval
+		{1100, 1120}, // late by more than an interval
+		{1121, 1140},
+	}
+	for i, c := range cases {
+		f.advanceDeadline(c.now)
+		if f.deadline != c.deadline {
+			t.Errorf("case %d: deadline at %d = %d, want %d", i, c.now, f.deadline, c.deadline)
+		}
+	}
+}
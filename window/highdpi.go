@@ -0,0 +1,72 @@
+package window
+
+import (
+	"github.com/cozely/platform/internal/sdl"
+)
+
+// DrawableSize returns the size of the framebuffer of the window, in pixels.
+// With the HighDPI option, it can be larger than Size on high density
+// displays.
+func (w *Window) DrawableSize() Coord {
+	checkThread("window.DrawableSize")
+	if w.closed {
+		return Coord{}
+	}
+	x, y := sdl.GLGetDrawableSize(w.handle)
+	return Coord{x, y}
+}
+
+// ContentScale returns the number of framebuffer pixels per window pixel,
+// e.g. 2 for a HighDPI window on a Retina display, and 1 in most other cases.
+func (w *Window) ContentScale() float32 {
+	s := w.windowSize()
+	if s.X == 0 {
+		return 1
+	}
+	return float32(w.DrawableSize().X) / float32(s.X)
+}
+
+// windowSize returns the actual size of the window, which can differ from the
+// requested one (e.g. in fullscreen) until the Resized event is received.
+func (w *Window) windowSize() Coord {
+	if w.handle == (sdl.Window{}) {
+		return w.size
+	}
+	x, y := sdl.GetWindowSize(w.handle)
+	return Coord{x, y}
+}
+
+// ToFramebuffer converts a position in window pixels (e.g. the position of
+// the mouse) to framebuffer pixels. Both have their origin at the top left.
+func (w *Window) ToFramebuffer(p Coord) Coord {
+	return rescale(p, w.windowSize(), w.DrawableSize())
+}
+
+// ToWindow converts a position in framebuffer pixels to window pixels. Both
+// have their origin at the top left.
+func (w *Window) ToWindow(p Coord) Coord {
+	return rescale(p, w.DrawableSize(), w.windowSize())
+}
+
+// rescale converts p from a space of size from to a space of size to, rounding
+// to the nearest pixel.
+func rescale(p, from, to Coord) Coord {
+	if from.X == 0 || from.Y == 0 {
+		return p
+	}
+	return Round(
+		float64(p.X)*float64(to.X)/float64(from.X),
+		float64(p.Y)*float64(to.Y)/float64(from.Y),
+	)
+}
+
+// checkScale updates the content scale of the window, and returns true if it
+// has changed.
+func (w *Window) checkScale() bool {
+	s := w.ContentScale()
+	if s == w.scale {
+		return false
+	}
+	w.scale = s
+	return true
+}
@@ -0,0 +1,40 @@
+package window
+
+import "testing"
+
+func TestRescale(t *testing.T) {
+	tests := []struct {
+		p, from, to, want Coord
+	}{
+		{Coord{10, 20}, Coord{640, 480}, Coord{640, 480}, Coord{10, 20}},
+		{Coord{10, 20}, Coord{640, 480}, Coord{1280, 960}, Coord{20, 40}},
+		{Coord{21, 41}, Coord{1280, 960}, Coord{640, 480}, Coord{11, 21}},
+		{Coord{3, 3}, Coord{100, 100}, Coord{150, 125}, Coord{5, 4}},
+		{Coord{7, 9}, Coord{0, 0}, Coord{640, 480}, Coord{7, 9}},
+	}
+	for _, tt := range tests {
+		if got := rescale(tt.p, tt.from, tt.to); got != tt.want {
+			t.Errorf("rescale(%v, %v, %v) = %v, want %v", tt.p, tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestDrawableSize(t *testing.T) {
+	w := newHeadless(t, HighDPI(true))
+	defer closeHeadless(w)
+
+	d := w.DrawableSize()
+	if d.X < 64 || d.Y < 48 {
+		t.Errorf("DrawableSize() = %v, want at least {64 48}", d)
+	}
+	s := w.ContentScale()
+	if s < 1 {
+		t.Errorf("ContentScale() = %v, want at least 1", s)
+	}
+	if p := w.ToWindow(w.ToFramebuffer(Coord{32, 24})); p != (Coord{32, 24}) {
+		t.Errorf("ToWindow(ToFramebuffer({32 24})) = %v", p)
+	}
+	if err := w.Set(HighDPI(false)); err == nil {
+		t.Errorf("HighDPI on an opened window did not fail")
+	}
+}
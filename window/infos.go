@@ -3,68 +3,144 @@ package window
 import (
 	"fmt"
 
+	"github.com/cozely/platform/internal/gl"
 	"github.com/cozely/platform/internal/sdl"
 )
 
-// InfoString returns information about the OpenGL context
-func InfoString() string {
-	s := "OpenGL "
+// ContextInfo describes an OpenGL context and its default framebuffer.
+type ContextInfo struct {
+	Profile      Profile
+	Major, Minor int
+	DoubleBuffer bool
+	Accelerated  bool
+	SwapInterval int // 0 without vsync, 1 with vsync, -1 with adaptive vsync
 
-	prof, err1 := sdlGLAttribute(sdl.GLContextProfileMask)
-	switch {
-	case err1 != nil:
-		s += "(error) "
-	case prof == sdl.GLContextProfileES:
-		s += "ES "
-	case prof == sdl.GLContextProfileCore:
-		s += "Core "
-	case prof == sdl.GLContextProfileCompatibility:
-		s += "Compatibility "
-	default:
-		s += "(unknown) "
-	}
+	RedBits, GreenBits, BlueBits, AlphaBits int
+	DepthBits, StencilBits                  int
+	Samples                                 int
+	SRGBCapable                             bool
 
-	maj, err1 := sdlGLAttribute(sdl.GLContextMajorVersion)
-	min, err2 := sdlGLAttribute(sdl.GLContextMinorVersion)
-	if err1 == nil && err2 == nil {
-		s += fmt.Sprintf("%d.%d", maj, min)
+	Vendor                 string
+	Renderer               string
+	Version                string
+	ShadingLanguageVersion string
+	Extensions             []string
+}
+
+// ContextInfo returns information about the OpenGL context of the window. If
+// an attribute cannot be queried, the first error is returned along with the
+// other attributes.
+func (w *Window) ContextInfo() (ContextInfo, error) {
+	err := w.MakeCurrent()
+	if err != nil {
+		return ContextInfo{}, err
 	}
+	return contextInfo()
+}
 
-	db, err1 := sdlGLAttribute(sdl.GLDoubleBuffer)
-	if err1 == nil {
-		if db != 0 {
-			s += ", double buffer"
-		} else {
-			s += ", NO double buffer"
+// contextInfo returns information about the current OpenGL context.
+func contextInfo() (ContextInfo, error) {
+	var c ContextInfo
+	var err error
+
+	attr := func(a sdl.GLAttr) int {
+		var v int32
+		e := sdl.GLGetAttribute(a, &v)
+		if e != nil && err == nil {
+			err = fmt.Errorf("window.ContextInfo: %w", sdlError("SDL_GL_GetAttribute", e))
 		}
+		return int(v)
 	}
 
-	av, err1 := sdlGLAttribute(sdl.GLAcceleratedVisual)
-	if err1 == nil {
-		if av != 0 {
-			s += ", accelerated"
-		} else {
-			s += ", NOT accelerated"
+	c.Profile = Profile(attr(sdl.GLContextProfileMask))
+	c.DoubleBuffer = attr(sdl.GLDoubleBuffer) != 0
+	c.Accelerated = attr(sdl.GLAcceleratedVisual) != 0
+	c.RedBits = attr(sdl.GLRedSize)
+	c.GreenBits = attr(sdl.GLGreenSize)
+	c.BlueBits = attr(sdl.GLBlueSize)
+	c.AlphaBits = attr(sdl.GLAlphaSize)
+	c.DepthBits = attr(sdl.GLDepthSize)
+	c.StencilBits = attr(sdl.GLStencilSize)
+	c.Samples = attr(sdl.GLMultisampleSamples)
+	c.SRGBCapable = attr(sdl.GLFramebufferSRGBCapable) != 0
+	c.SwapInterval = int(sdl.GLGetSwapInterval())
+
+	if !gl.WasInit() {
+		if err == nil {
+			err = fmt.Errorf("window.ContextInfo: OpenGL not initialized")
 		}
+		return c, err
+	}
+
+	var v int32
+	gl.GetIntegerv(gl.MAJOR_VERSION, &v)
+	c.Major = int(v)
+	gl.GetIntegerv(gl.MINOR_VERSION, &v)
+	c.Minor = int(v)
+
+	c.Vendor = gl.GetString(gl.VENDOR)
+	c.Renderer = gl.GetString(gl.RENDERER)
+	c.Version = gl.GetString(gl.VERSION)
+	c.ShadingLanguageVersion = gl.GetString(gl.SHADING_LANGUAGE_VERSION)
+
+	var n int32
+	gl.GetIntegerv(gl.NUM_EXTENSIONS, &n)
+	c.Extensions = make([]string, n)
+	for i := range c.Extensions {
+		c.Extensions[i] = gl.GetStringi(gl.EXTENSIONS, uint32(i))
 	}
 
-	sw := sdl.GLGetSwapInterval()
-	if sw > 0 {
-		if sw != 0 {
-			s += ", vsync"
-		} else {
-			s += ", NO vsync"
+	return c, err
+}
+
+// HasExtension returns true if the extension named e is supported by the
+// context.
+func (c *ContextInfo) HasExtension(e string) bool {
+	for _, x := range c.Extensions {
+		if x == e {
+			return true
 		}
 	}
+	return false
+}
+
+// String returns a human-readable summary of the context.
+func (c ContextInfo) String() string {
+	s := fmt.Sprintf("OpenGL %v %d.%d", c.Profile, c.Major, c.Minor)
+
+	if c.DoubleBuffer {
+		s += ", double buffer"
+	} else {
+		s += ", NO double buffer"
+	}
+
+	if c.Accelerated {
+		s += ", accelerated"
+	} else {
+		s += ", NOT accelerated"
+	}
+
+	switch {
+	case c.SwapInterval < 0:
+		s += ", adaptive vsync"
+	case c.SwapInterval > 0:
+		s += ", vsync"
+	default:
+		s += ", NO vsync"
+	}
+
+	if c.Renderer != "" {
+		s += " (" + c.Renderer + ")"
+	}
 
 	return s
 }
 
-func sdlGLAttribute(attr sdl.GLAttr) (int32, error) {
-	var v int32
-	err := sdl.GLGetAttribute(attr, &v)
+// InfoString returns information about the current OpenGL context.
+func InfoString() string {
+	c, err := contextInfo()
 	if err != nil {
-		return 0, err
+		return c.String() + " (error: " + err.Error() + ")"
 	}
-	return v, nil
+	return c.String()
 }
@@ -0,0 +1,110 @@
+package window
+
+import (
+	"runtime"
+	"sync/atomic"
+
+	"github.com/cozely/platform/internal/sdl"
+)
+
+// SDL and OpenGL must be used from the thread that created the windows; on
+// some platforms, this must also be the main thread of the process. The main
+// goroutine is therefore locked to the main thread during initialization.
+func init() {
+	runtime.LockOSThread()
+	mainThread = sdl.ThreadID()
+}
+
+var (
+	mainThread  uint64
+	mainFuncs   = make(chan func(), 64)
+	mainRunning int32
+	checked     int32
+)
+
+// Main runs f in a new goroutine, while the main thread executes the functions
+// given to Do and DoAsync. It returns when f returns. Main must be called from
+// the main goroutine, usually at the start of the main function:
+//
+//	func main() {
+//		window.Main(run)
+//	}
+func Main(f func()) {
+	if !onMainThread() {
+		panic("window.Main: not called from the main goroutine")
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		f()
+	}()
+
+	atomic.StoreInt32(&mainRunning, 1)
+	defer atomic.StoreInt32(&mainRunning, 0)
+	for {
+		select {
+		case g := <-mainFuncs:
+			g()
+		case <-done:
+			return
+		}
+	}
+}
+
+// Do runs f on the main thread, and waits for it to complete. When called from
+// the main thread, f is run directly; otherwise Main must be running.
+func Do(f func()) {
+	if onMainThread() {
+		f()
+		return
+	}
+	if atomic.LoadInt32(&mainRunning) == 0 {
+		panic("window.Do: Main is not running")
+	}
+
+	done := make(chan struct{})
+	mainFuncs <- func() {
+		defer close(done)
+		f()
+	}
+	<-done
+}
+
+// DoAsync schedules f to run on the main thread, and returns without waiting
+// (unless too many functions are already pending). When called from the main
+// thread, f is run directly; otherwise Main must be running.
+func DoAsync(f func()) {
+	if onMainThread() {
+		f()
+		return
+	}
+	if atomic.LoadInt32(&mainRunning) == 0 {
+		panic("window.DoAsync: Main is not running")
+	}
+
+	mainFuncs <- f
+}
+
+// CheckThread enables or disables the checked mode, in which the functions of
+// the package that must run on the main thread panic when called from another
+// thread. It is disabled by default.
+func CheckThread(enable bool) {
+	if enable {
+		atomic.StoreInt32(&checked, 1)
+	} else {
+		atomic.StoreInt32(&checked, 0)
+	}
+}
+
+func onMainThread() bool {
+	return sdl.ThreadID() == mainThread
+}
+
+// checkThread panics, in checked mode, if the calling goroutine is not
+// running on the main thread.
+func checkThread(name string) {
+	if atomic.LoadInt32(&checked) != 0 && !onMainThread() {
+		panic(name + ": called outside of the main thread (see window.Do)")
+	}
+}
@@ -0,0 +1,54 @@
+package window
+
+import (
+	"os"
+	"testing"
+
+	"github.com/cozely/platform/internal/sdl"
+)
+
+func TestMain(m *testing.M) {
+	var code int
+	Main(func() {
+		code = m.Run()
+	})
+	os.Exit(code)
+}
+
+func TestDo(t *testing.T) {
+	if onMainThread() {
+		t.Fatal("test running on the main thread")
+	}
+
+	var id uint64
+	Do(func() {
+		id = sdl.ThreadID()
+	})
+	if id != mainThread {
+		t.Errorf("Do ran on thread %d, want main thread %d", id, mainThread)
+	}
+
+	done := make(chan uint64)
+	DoAsync(func() {
+		done <- sdl.ThreadID()
+	})
+	if id := <-done; id != mainThread {
+		t.Errorf("DoAsync ran on thread %d, want main thread %d", id, mainThread)
+	}
+}
+
+func TestCheckThread(t *testing.T) {
+	CheckThread(true)
+	defer CheckThread(false)
+
+	Do(func() {
+		checkThread("TestCheckThread")
+	})
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("checkThread did not panic outside of the main thread")
+		}
+	}()
+	checkThread("TestCheckThread")
+}
@@ -0,0 +1,137 @@
+package window
+
+import "github.com/cozely/platform/internal/sdl"
+
+// MouseButton identifies a button of the mouse.
+type MouseButton uint8
+
+// Mouse buttons.
+const (
+	ButtonLeft   MouseButton = MouseButton(sdl.ButtonLeft)
+	ButtonMiddle MouseButton = MouseButton(sdl.ButtonMiddle)
+	ButtonRight  MouseButton = MouseButton(sdl.ButtonRight)
+	ButtonX1     MouseButton = MouseButton(sdl.ButtonX1)
+	ButtonX2     MouseButton = MouseButton(sdl.ButtonX2)
+)
+
+// MouseMotion is sent when the mouse moves. Position is in window pixels; in
+// relative mode it does not change, and only Delta is meaningful.
+type MouseMotion struct {
+	WindowID uint32
+	Position Coord
+	Delta    Coord
+}
+
+// MouseButtonDown is sent when a mouse button is pressed. Clicks is 1 for a
+// single click, 2 for a double click, and so on.
+type MouseButtonDown struct {
+	WindowID uint32
+	Button   MouseButton
+	Position Coord
+	Clicks   int
+}
+
+// MouseButtonUp is sent when a mouse button is released.
+type MouseButtonUp struct {
+	WindowID uint32
+	Button   MouseButton
+	Position Coord
+	Clicks   int
+}
+
+// MouseWheel is sent when the mouse wheel is scrolled. Y is positive when
+// scrolling away from the user, and X is positive when scrolling to the right.
+// The deltas are fractional on devices that support it (SDL 2.0.18 or later).
+type MouseWheel struct {
+	WindowID uint32
+	X, Y     float32
+}
+
+// preciseWheel is true if the version of SDL sets the precise amounts of the
+// mouse wheel events.
+var preciseWheel bool
+
+func translateMouse(e *sdl.Event) Event {
+	switch e.Type {
+	case sdl.EventMouseMotion:
+		m := e.Motion()
+		w := lookup(m.WindowID)
+		p := Coord{m.X, m.Y}
+		if w != nil {
+			w.mouse = p
+		}
+		return MouseMotion{WindowID: m.WindowID, Position: p, Delta: Coord{m.XRel, m.YRel}}
+
+	case sdl.EventMouseButtonDown, sdl.EventMouseButtonUp:
+		b := e.Button()
+		w := lookup(b.WindowID)
+		p := Coord{b.X, b.Y}
+		if w != nil {
+			w.mouse = p
+		}
+		if e.Type == sdl.EventMouseButtonDown {
+			return MouseButtonDown{WindowID: b.WindowID, Button: MouseButton(b.Button), Position: p, Clicks: int(b.Clicks)}
+		}
+		return MouseButtonUp{WindowID: b.WindowID, Button: MouseButton(b.Button), Position: p, Clicks: int(b.Clicks)}
+
+	case sdl.EventMouseWheel:
+		m := e.Wheel()
+		x, y := float32(m.X), float32(m.Y)
+		if preciseWheel {
+			x, y = m.PreciseX, m.PreciseY
+		}
+		if m.Direction == sdl.MouseWheelFlipped {
+			x, y = -x, -y
+		}
+		return MouseWheel{WindowID: m.WindowID, X: x, Y: y}
+	}
+	return nil
+}
+
+// MousePosition returns the last known position of the mouse inside the
+// window, in window pixels. It is only meaningful when the window has mouse
+// focus.
+func (w *Window) MousePosition() Coord {
+	return w.mouse
+}
+
+// WarpMouse moves the mouse cursor to position p inside the window.
+func (w *Window) WarpMouse(p Coord) {
+	checkThread("window.WarpMouse")
+	if w.closed {
+		return
+	}
+	sdl.WarpMouseInWindow(w.handle, p.X, p.Y)
+	w.mouse = p
+}
+
+// IsMouseButtonPressed returns true if button b is currently pressed. The
+// state is updated by PollEvents.
+func IsMouseButtonPressed(b MouseButton) bool {
+	checkThread("window.IsMouseButtonPressed")
+	s := sdl.GetMouseState(nil, nil)
+	return s&sdl.ButtonMask(uint8(b)) != 0
+}
+
+// SetRelativeMouse enables or disables relative mouse mode. In this mode the
+// cursor is hidden, confined to the window with focus, and only the deltas
+// of MouseMotion events are reported, even at the border of the screen. It is
+// intended for FPS-style cameras.
+func SetRelativeMouse(enable bool) error {
+	checkThread("window.SetRelativeMouse")
+	return sdl.SetRelativeMouseMode(enable)
+}
+
+// RelativeMouse returns true if relative mouse mode is enabled.
+func RelativeMouse() bool {
+	checkThread("window.RelativeMouse")
+	return sdl.GetRelativeMouseMode()
+}
+
+// CaptureMouse enables or disables mouse capture: while enabled, mouse events
+// are reported to the window with focus even when the cursor is outside of it
+// (e.g. while dragging).
+func CaptureMouse(enable bool) error {
+	checkThread("window.CaptureMouse")
+	return sdl.CaptureMouse(enable)
+}
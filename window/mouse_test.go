@@ -0,0 +1,37 @@
+package window
+
+import (
+	"testing"
+
+	"github.com/cozely/platform/internal/sdl"
+)
+
+func TestTranslateWheel(t *testing.T) {
+	defer func(p bool) { preciseWheel = p }(preciseWheel)
+
+	var e sdl.Event
+	e.Type = sdl.EventMouseWheel
+	m := e.Wheel()
+	m.X, m.Y = 1, -2
+	m.PreciseX, m.PreciseY = 0.5, -1.5
+
+	tests := []struct {
+		precise bool
+		flipped bool
+		want    MouseWheel
+	}{
+		{false, false, MouseWheel{X: 1, Y: -2}},
+		{true, false, MouseWheel{X: 0.5, Y: -1.5}},
+		{true, true, MouseWheel{X: -0.5, Y: 1.5}},
+	}
+	for _, tt := range tests {
+		preciseWheel = tt.precise
+		m.Direction = sdl.MouseWheelNormal
+		if tt.flipped {
+			m.Direction = sdl.MouseWheelFlipped
+		}
+		if got := translate(&e); got != tt.want {
+			t.Errorf("precise %v, flipped %v: got %#v, want %#v", tt.precise, tt.flipped, got, tt.want)
+		}
+	}
+}
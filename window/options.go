@@ -1,69 +1,370 @@
 package window
 
-import "errors"
+import (
+	"errors"
+	"os"
+	"time"
 
+	"github.com/cozely/platform/internal/sdl"
+)
+
+// An Option configures a window. Options are given to New, and can also be
+// applied to an opened window with Set.
 type Option func(*Window) error
 
+// Title sets the title of the window.
 func Title(s string) Option {
 	return func(w *Window) error {
+		w.title = s
 		if w.opened {
-			//TODO: implement
-			return errors.New("window.Title: not implemented for opened windows")
+			sdl.SetWindowTitle(w.handle, s)
 		}
-		w.title = s
 		return nil
 	}
 }
 
+// Size sets the size of the window, in (screen) pixels. For an opened window,
+// the new size is only reflected by Size once the corresponding Resized event
+// has been received.
 func Size(x, y int32) Option {
 	return func(w *Window) error {
 		if w.opened {
-			//TODO: implement
-			return errors.New("window.Size: not implemented for opened windows")
+			sdl.SetWindowSize(w.handle, x, y)
+			return nil
 		}
 		w.size = Coord{x, y}
 		return nil
 	}
 }
 
-func Fullscreen(fullscreen bool, windowed bool) Option {
+// Fullscreen switches the window to fullscreen or back. If desktop is true, the
+// fullscreen mode uses a borderless window the size of the desktop; otherwise
+// the video mode is changed.
+//
+// The second argument used to be named windowed, and had the opposite meaning:
+// calls written for the old version must negate it.
+func Fullscreen(fullscreen bool, desktop bool) Option {
 	return func(w *Window) error {
+		w.fullscreen = fullscreen
+		w.desktop = desktop
 		if w.opened {
-			//TODO: implement
-			return errors.New("window.Fullscreen: not implemented for opened windows")
+			err := sdl.SetWindowFullscreen(w.handle, w.fullscreenFlags())
+			return sdlError("SDL_SetWindowFullscreen", err)
 		}
-		w.fullscreen = fullscreen
-		w.desktop = !windowed
 		return nil
 	}
 }
 
+// Monitor selects the display on which the window is centered. An opened
+// window is moved to the new display, staying fullscreen if it was.
 func Monitor(n int) Option {
 	return func(w *Window) error {
-		if w.opened {
-			return errors.New("window.Monitor: not implemented for opened windows")
-		}
 		w.monitor = int32(n)
+		if !w.opened {
+			return nil
+		}
+
+		f := w.fullscreenFlags()
+		if f != 0 {
+			err := sdl.SetWindowFullscreen(w.handle, 0)
+			if err != nil {
+				return sdlError("SDL_SetWindowFullscreen", err)
+			}
+		}
+		sdl.SetWindowPosition(
+			w.handle,
+			sdl.WindowPosCenteredDisplay(w.monitor),
+			sdl.WindowPosCenteredDisplay(w.monitor),
+		)
+		if f != 0 {
+			err := sdl.SetWindowFullscreen(w.handle, f)
+			return sdlError("SDL_SetWindowFullscreen", err)
+		}
 		return nil
 	}
 }
 
+// VSync enables or disables the synchronization of Present with the vertical
+// retrace of the display.
 func VSync(enable bool) Option {
 	return func(w *Window) error {
+		w.vsync = enable
 		if w.opened {
-			return errors.New("internal.VSync: not implemented for opened windows")
+			return w.setSwapInterval()
 		}
-		w.vsync = enable
 		return nil
 	}
 }
 
+// FrameLimit limits the rate of Present to fps frames per second, by sleeping
+// as needed. It is meant for when VSync is disabled, to avoid rendering more
+// frames than can be displayed. A limit of zero (the default) disables it.
+func FrameLimit(fps float64) Option {
+	return func(w *Window) error {
+		if fps <= 0 {
+			w.frames.limit = 0
+			return nil
+		}
+		w.frames.limit = time.Duration(float64(time.Second) / fps)
+		w.frames.deadline = 0
+		return nil
+	}
+}
+
+// Debug enables or disables the debug flag of the OpenGL context. Like all
+// context options, changing it on an opened window recreates the context: all
+// OpenGL objects of the window are lost. In debug mode, a warning is also
+// logged when a window is garbage collected without being closed.
+//
+// The flag is set by default, in release builds too: the errors reported by
+// the driver on the machines of the users are then logged (see DebugHandler).
+// Disable it if the overhead of a debug context matters.
 func Debug(enable bool) Option {
 	return func(w *Window) error {
-		if w.opened {
-			return errors.New("internal.Debug: not implemented for opened windows")
+		if enable == w.debug {
+			return nil
 		}
 		w.debug = enable
+		if !w.opened {
+			return nil
+		}
+
+		return w.recreateContext()
+	}
+}
+
+// FullscreenMode switches the window to exclusive fullscreen, on the display
+// and with the resolution and refresh rate of m (usually one of the modes
+// returned by Displays). The closest supported mode is used.
+func FullscreenMode(m DisplayMode) Option {
+	return func(w *Window) error {
+		w.fullscreenMode = &m
+		if !w.opened {
+			w.fullscreen = true
+			w.desktop = false
+			w.monitor = int32(m.Display)
+			w.size = m.Size
+			return nil
+		}
+
+		if w.monitor != int32(m.Display) {
+			err := Monitor(m.Display)(w)
+			if err != nil {
+				return err
+			}
+		}
+		sm := m.toSDL()
+		err := sdl.SetWindowDisplayMode(w.handle, &sm)
+		if err != nil {
+			return sdlError("SDL_SetWindowDisplayMode", err)
+		}
+		w.fullscreen = true
+		w.desktop = false
+		err = sdl.SetWindowFullscreen(w.handle, sdl.WindowFullscreen)
+		return sdlError("SDL_SetWindowFullscreen", err)
+	}
+}
+
+// GLVersion requests an OpenGL context of the given version. If it is not
+// available, the lower versions of the same profile are tried in turn.
+func GLVersion(major, minor int) Option {
+	return func(w *Window) error {
+		w.glConfig.major = int32(major)
+		w.glConfig.minor = int32(minor)
+		if w.opened {
+			return w.recreateContext()
+		}
+		return nil
+	}
+}
+
+// GLProfile selects the profile of the OpenGL context (GLCore, GLCompat or
+// GLES). The platform packages only use the commands common to all profiles;
+// the application needs its own OpenGL binding for the others.
+func GLProfile(p Profile) Option {
+	return func(w *Window) error {
+		w.glConfig.profile = p
+		if w.opened {
+			return w.recreateContext()
+		}
+		return nil
+	}
+}
+
+// ForwardCompatible requests a forward-compatible context, i.e. one without
+// the deprecated functionality.
+func ForwardCompatible(enable bool) Option {
+	return func(w *Window) error {
+		w.glConfig.forwardCompatible = enable
+		if w.opened {
+			return w.recreateContext()
+		}
+		return nil
+	}
+}
+
+// Robust requests a context with robust buffer access.
+func Robust(enable bool) Option {
+	return func(w *Window) error {
+		w.glConfig.robust = enable
+		if w.opened {
+			return w.recreateContext()
+		}
+		return nil
+	}
+}
+
+// NoError requests a context in which OpenGL errors are undefined behavior,
+// avoiding the cost of error checking (KHR_no_error).
+func NoError(enable bool) Option {
+	return func(w *Window) error {
+		w.glConfig.noError = enable
+		if w.opened {
+			return w.recreateContext()
+		}
+		return nil
+	}
+}
+
+// ShareContext creates the OpenGL context of the window in the same share
+// group as the context of other, so that textures, buffers and shaders are
+// shared between them. The two contexts must have compatible versions and
+// profiles. On an opened window, the context is recreated.
+func ShareContext(other *Window) Option {
+	return func(w *Window) error {
+		w.glConfig.share = other
+		if w.opened {
+			return w.recreateContext()
+		}
+		return nil
+	}
+}
+
+// DepthBits sets the minimum number of bits of the depth buffer (default 16).
+// It cannot be changed on an opened window.
+func DepthBits(n int) Option {
+	return func(w *Window) error {
+		if w.opened {
+			return errors.New("window.DepthBits: cannot change the framebuffer of an opened window")
+		}
+		w.glConfig.depthBits = int32(n)
+		return nil
+	}
+}
+
+// StencilBits sets the minimum number of bits of the stencil buffer (default
+// 0). It cannot be changed on an opened window.
+func StencilBits(n int) Option {
+	return func(w *Window) error {
+		if w.opened {
+			return errors.New("window.StencilBits: cannot change the framebuffer of an opened window")
+		}
+		w.glConfig.stencilBits = int32(n)
+		return nil
+	}
+}
+
+// Multisample sets the number of samples per pixel of the framebuffer, or
+// disables multisampling if n is zero (the default). It cannot be changed on
+// an opened window.
+func Multisample(n int) Option {
+	return func(w *Window) error {
+		if w.opened {
+			return errors.New("window.Multisample: cannot change the framebuffer of an opened window")
+		}
+		w.glConfig.multisample = int32(n)
+		return nil
+	}
+}
+
+// SRGB requests an sRGB-capable framebuffer. It cannot be changed on an
+// opened window.
+func SRGB(enable bool) Option {
+	return func(w *Window) error {
+		if w.opened {
+			return errors.New("window.SRGB: cannot change the framebuffer of an opened window")
+		}
+		w.glConfig.srgb = enable
+		return nil
+	}
+}
+
+// HighDPI creates the window in high-DPI mode, if supported: on high density
+// displays, the framebuffer then has more pixels than the window (see
+// DrawableSize and ContentScale). It cannot be changed on an opened window.
+func HighDPI(enable bool) Option {
+	return func(w *Window) error {
+		if w.opened {
+			return errors.New("window.HighDPI: cannot change the framebuffer of an opened window")
+		}
+		w.highDPI = enable
+		return nil
+	}
+}
+
+// Headless selects the offscreen video driver of SDL, which renders without
+// any display (in an EGL pbuffer, e.g. with Mesa's software rasterizer). It
+// requires SDL 2.0.22 or later. Since the video driver is shared by all
+// windows, it must be given to New before any other window is opened.
+func Headless(enable bool) Option {
+	return func(w *Window) error {
+		if w.opened {
+			return errors.New("window.Headless: cannot change the video driver of an opened window")
+		}
+		w.headless = enable
+		return nil
+	}
+}
+
+// Capture saves a screenshot of every n-th frame to the directory dir (created
+// if necessary), as PNG files named after the frame number. The screenshots
+// are taken by Present, just before the buffers are swapped. Capturing is
+// disabled if n is zero, or after the first error.
+func Capture(dir string, n int) Option {
+	return func(w *Window) error {
+		if n > 0 {
+			err := os.MkdirAll(dir, 0755)
+			if err != nil {
+				return err
+			}
+		}
+		w.captureDir = dir
+		w.captureEvery = n
+		w.captureFrame = 0
+		return nil
+	}
+}
+
+// DebugHandler sets the function receiving the messages of the OpenGL debug
+// output, when the window is in debug mode. The default handler is
+// LogDebugMessage.
+func DebugHandler(h func(DebugMessage)) Option {
+	return func(w *Window) error {
+		if h == nil {
+			h = LogDebugMessage
+		}
+		w.debugHandler = h
+		return nil
+	}
+}
+
+// DebugFilter discards the debug messages with a severity lower than min. The
+// default is SeverityLow.
+func DebugFilter(min Severity) Option {
+	return func(w *Window) error {
+		w.debugFilter = min
+		if w.opened {
+			w.setupDebug()
+		}
+		return nil
+	}
+}
+
+// DebugPanic makes the high severity debug messages panic, after they have
+// been handled. It is enabled by default in builds with the "debug" tag.
+func DebugPanic(enable bool) Option {
+	return func(w *Window) error {
+		w.debugPanic = enable
 		return nil
 	}
 }
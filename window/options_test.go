@@ -0,0 +1,138 @@
+package window
+
+import (
+	"testing"
+
+	"github.com/cozely/platform/internal/gl"
+	"github.com/cozely/platform/internal/sdl"
+)
+
+func TestSet(t *testing.T) {
+	w := newHeadless(t)
+	defer closeHeadless(w)
+
+	err := w.Set(Title("TestSet"), VSync(false))
+	if err != nil {
+		t.Errorf("Set(Title, VSync) = %v", err)
+	}
+	if w.title != "TestSet" {
+		t.Errorf("title = %q, want %q", w.title, "TestSet")
+	}
+
+	// Options that cannot be applied to an opened window
+	for _, o := range []Option{DepthBits(24), StencilBits(8), Multisample(4), SRGB(true), Headless(false)} {
+		if err := w.Set(o); err == nil {
+			t.Errorf("Set on an opened window did not fail")
+		}
+	}
+}
+
+func TestDebugOutput(t *testing.T) {
+	var got []DebugMessage
+	w := newHeadless(t,
+		Debug(true),
+		DebugPanic(false),
+		DebugFilter(SeverityNotification),
+		DebugHandler(func(m DebugMessage) { got = append(got, m) }),
+	)
+	defer closeHeadless(w)
+
+	c, err := w.ContextInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !c.HasExtension("GL_KHR_debug") && c.Major < 4 {
+		t.Skip("KHR_debug not supported")
+	}
+
+	// An invalid enum must be reported as an error by the debug output
+	gl.Enable(0xFFFF)
+	gl.GetError()
+
+	for _, m := range got {
+		if m.Type == TypeError && m.WindowID == w.ID() {
+			return
+		}
+	}
+	t.Errorf("no error message received (got %d messages)", len(got))
+}
+
+func TestFullscreenFlags(t *testing.T) {
+	tests := []struct {
+		fullscreen, desktop bool
+		want                sdl.WindowFlags
+	}{
+		{false, false, 0},
+		{false, true, 0},
+		{true, false, sdl.WindowFullscreen},
+		{true, true, sdl.WindowFullscreenDesktop},
+	}
+	for _, tt := range tests {
+		w := Window{&window{}}
+		err := Fullscreen(tt.fullscreen, tt.desktop)(&w)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f := w.fullscreenFlags(); f != tt.want {
+			t.Errorf("Fullscreen(%v, %v): flags = %#x, want %#x", tt.fullscreen, tt.desktop, f, tt.want)
+		}
+	}
+}
+
+func TestFullscreenModeFlags(t *testing.T) {
+	m := DisplayMode{Display: 1, Size: Coord{800, 600}, RefreshRate: 60}
+	for _, o := range []Option{Fullscreen(false, false), Fullscreen(true, true)} {
+		w := Window{&window{}}
+		if err := o(&w); err != nil {
+			t.Fatal(err)
+		}
+		if err := FullscreenMode(m)(&w); err != nil {
+			t.Fatal(err)
+		}
+		if f := w.fullscreenFlags(); f != sdl.WindowFullscreen {
+			t.Errorf("flags after FullscreenMode = %#x, want %#x", f, sdl.WindowFullscreen)
+		}
+		if w.monitor != 1 || w.size != m.Size {
+			t.Errorf("monitor, size = %d, %v, want 1, %v", w.monitor, w.size, m.Size)
+		}
+	}
+}
+
+func TestFullscreenModeOpened(t *testing.T) {
+	w := newHeadless(t)
+	defer closeHeadless(w)
+
+	dd, err := Displays()
+	if err != nil || len(dd) == 0 {
+		t.Skip("no display mode available")
+	}
+	if err := w.Set(Fullscreen(true, true)); err != nil {
+		t.Skipf("fullscreen not supported: %v", err)
+	}
+	if err := w.Set(FullscreenMode(dd[0].Desktop)); err != nil {
+		t.Skipf("fullscreen mode not supported: %v", err)
+	}
+	if f := w.fullscreenFlags(); f != sdl.WindowFullscreen {
+		t.Errorf("flags after FullscreenMode = %#x, want %#x", f, sdl.WindowFullscreen)
+	}
+}
+
+func TestRecreateContext(t *testing.T) {
+	w := newHeadless(t)
+	defer closeHeadless(w)
+
+	// Debug is enabled by default
+	old := w.context
+	if err := w.Set(Debug(false)); err != nil {
+		t.Fatal(err)
+	}
+	if w.context == old {
+		t.Errorf("context not replaced")
+	}
+	if _, err := w.ContextInfo(); err != nil {
+		t.Errorf("ContextInfo after recreation = %v", err)
+	}
+	if err := w.Present(); err != nil {
+		t.Errorf("Present after recreation = %v", err)
+	}
+}
@@ -0,0 +1,160 @@
+package window
+
+import (
+	"fmt"
+	"log"
+	"runtime"
+	"sort"
+	"sync"
+
+	"github.com/cozely/platform/internal/sdl"
+)
+
+// windows maps the SDL window IDs to the opened windows. A window is closed
+// automatically once the garbage collector has finalized all its handles. The
+// mutex is needed because finalizers run in their own goroutine.
+var (
+	windows   = map[uint32]*window{}
+	leaked    []*window
+	windowsMu sync.Mutex
+)
+
+// current is the window whose context was last made current, on the thread
+// currentThread.
+var (
+	current       uint32
+	currentThread uint64
+)
+
+// register adds an opened window to the registry. The handle w is tracked like
+// the ones returned by Lookup and Windows.
+func register(w *Window) {
+	windowsMu.Lock()
+	windows[w.id] = w.window
+	w.handles++
+	windowsMu.Unlock()
+	runtime.SetFinalizer(w, finalize)
+}
+
+// unregister removes a window from the registry. The finalizers of its handles
+// have no effect afterwards.
+func unregister(w *Window) {
+	windowsMu.Lock()
+	delete(windows, w.id)
+	windowsMu.Unlock()
+}
+
+// lookup returns the opened window with the given SDL ID, or nil.
+func lookup(id uint32) *window {
+	windowsMu.Lock()
+	defer windowsMu.Unlock()
+	return windows[id]
+}
+
+// opened returns the opened windows, in the order of their creation.
+func opened() []*window {
+	windowsMu.Lock()
+	ww := make([]*window, 0, len(windows))
+	for _, w := range windows {
+		ww = append(ww, w)
+	}
+	windowsMu.Unlock()
+	// SDL allocates the IDs in increasing order
+	sort.Slice(ww, func(i, j int) bool { return ww[i].id < ww[j].id })
+	return ww
+}
+
+// newHandle returns a new handle on w. The registry mutex must be held.
+func newHandle(w *window) *Window {
+	w.handles++
+	h := &Window{w}
+	runtime.SetFinalizer(h, finalize)
+	return h
+}
+
+// finalize is called by the garbage collector for each handle. When the last
+// handle on a window that was not closed is finalized, the window is removed
+// from the registry. Since finalizers do not run on the main thread, it is
+// only queued, to be closed by the next call to New or PollEvents.
+func finalize(h *Window) {
+	windowsMu.Lock()
+	w := h.window
+	w.handles--
+	if w.handles > 0 || windows[w.id] != w {
+		windowsMu.Unlock()
+		return
+	}
+	delete(windows, w.id)
+	leaked = append(leaked, w)
+	windowsMu.Unlock()
+	if w.debug {
+		log.Printf("window: window %d (%q) garbage collected without being closed", w.id, w.title)
+	}
+}
+
+// closeLeaked closes the windows queued by finalize.
+func closeLeaked() {
+	windowsMu.Lock()
+	ww := leaked
+	leaked = nil
+	windowsMu.Unlock()
+	for _, w := range ww {
+		(&Window{w}).Close()
+	}
+}
+
+// Windows returns the opened windows, in the order of their creation. The
+// handles are not the ones returned by New (see Window).
+func Windows() []*Window {
+	windowsMu.Lock()
+	ww := make([]*Window, 0, len(windows))
+	for _, w := range windows {
+		ww = append(ww, newHandle(w))
+	}
+	windowsMu.Unlock()
+	// SDL allocates the IDs in increasing order
+	sort.Slice(ww, func(i, j int) bool { return ww[i].id < ww[j].id })
+	return ww
+}
+
+// Lookup returns the opened window with the given ID, or nil. The handle is
+// not the one returned by New (see Window).
+func Lookup(id uint32) *Window {
+	windowsMu.Lock()
+	defer windowsMu.Unlock()
+	w, ok := windows[id]
+	if !ok {
+		return nil
+	}
+	return newHandle(w)
+}
+
+// ID returns the identifier of the window, as used by the system, or 0 if w is
+// nil.
+func (w *Window) ID() uint32 {
+	if w == nil {
+		return 0
+	}
+	return w.id
+}
+
+// MakeCurrent makes the OpenGL context of the window current on the calling
+// thread: subsequent OpenGL calls operate on it. Present and Screenshot make
+// the context current automatically.
+func (w *Window) MakeCurrent() error {
+	checkThread("window.MakeCurrent")
+	if w.closed {
+		return ErrClosed
+	}
+
+	t := sdl.ThreadID()
+	if current == w.id && currentThread == t {
+		return nil
+	}
+	err := sdl.GLMakeCurrent(w.handle, w.context)
+	if err != nil {
+		return fmt.Errorf("window.MakeCurrent: %w", sdlError("SDL_GL_MakeCurrent", err))
+	}
+	current, currentThread = w.id, t
+	return nil
+}
@@ -0,0 +1,69 @@
+package window
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/cozely/platform/internal/gl"
+)
+
+func TestShareContext(t *testing.T) {
+	a := newHeadless(t)
+	defer closeHeadless(a)
+	b := newHeadless(t, ShareContext(a))
+	defer closeHeadless(b)
+
+	ww := Windows()
+	if len(ww) != 2 || ww[0].ID() != a.ID() || ww[1].ID() != b.ID() {
+		t.Fatalf("Windows() does not return windows %d and %d", a.ID(), b.ID())
+	}
+	if Lookup(b.ID()).ID() != b.ID() {
+		t.Errorf("Lookup(%d) does not return the window", b.ID())
+	}
+
+	// Each window keeps its own framebuffer
+	colors := map[*Window][4]float32{
+		a: {1, 0, 0, 1},
+		b: {0, 0, 1, 1},
+	}
+	for w, c := range colors {
+		err := w.MakeCurrent()
+		if err != nil {
+			t.Fatal(err)
+		}
+		gl.ClearBufferfv(gl.COLOR, 0, &c[0])
+	}
+	for w, c := range colors {
+		m, err := w.Screenshot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		p := m.RGBAAt(10, 10)
+		if p.R != uint8(c[0]*255) || p.B != uint8(c[2]*255) {
+			t.Errorf("window %d: pixel = %v, want %v", w.ID(), p, c)
+		}
+	}
+}
+
+func TestFinalize(t *testing.T) {
+	w := &window{id: 1 << 30}
+	windowsMu.Lock()
+	windows[w.id] = w
+	a, b := newHandle(w), newHandle(w)
+	windowsMu.Unlock()
+	runtime.SetFinalizer(a, nil)
+	runtime.SetFinalizer(b, nil)
+	defer func() {
+		delete(windows, w.id)
+		leaked = nil
+	}()
+
+	finalize(a)
+	if windows[w.id] != w || len(leaked) != 0 {
+		t.Fatalf("window released while a handle remains")
+	}
+	finalize(b)
+	if windows[w.id] != nil || len(leaked) != 1 || leaked[0] != w {
+		t.Errorf("window not queued after its last handle was finalized")
+	}
+}
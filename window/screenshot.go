@@ -0,0 +1,132 @@
+package window
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/png"
+	"log"
+	"os"
+	"path/filepath"
+	"unsafe"
+
+	"github.com/cozely/platform/internal/gl"
+	"github.com/cozely/platform/internal/sdl"
+)
+
+// Screenshot returns the content of the back buffer of the window, i.e. what
+// has been drawn since the last call to Present. The image has the size of the
+// framebuffer, with the origin at the top left. The content of the back buffer
+// is undefined after Present, until something is drawn again. With a
+// single-buffered desktop OpenGL context, the front buffer is read instead.
+//
+// The pixels are returned as stored in the framebuffer, without any color
+// conversion: with an sRGB framebuffer (see SRGB), they are sRGB-encoded, as
+// expected by PNG and the image package. The alpha channel is ignored, and the
+// image is opaque.
+func (w *Window) Screenshot() (*image.RGBA, error) {
+	checkThread("window.Screenshot")
+	err := w.MakeCurrent()
+	if err != nil {
+		return nil, err
+	}
+
+	sx, sy := sdl.GLGetDrawableSize(w.handle)
+	if sx <= 0 || sy <= 0 {
+		return nil, errors.New("window.Screenshot: empty framebuffer")
+	}
+	m := image.NewRGBA(image.Rect(0, 0, int(sx), int(sy)))
+
+	// Read from the back buffer of the default framebuffer, restoring the state
+	// afterward. In OpenGL ES, BACK designates the only buffer of a
+	// single-buffered framebuffer, but desktop OpenGL requires FRONT.
+	src := gl.BACK
+	var db int32
+	err = sdl.GLGetAttribute(sdl.GLDoubleBuffer, &db)
+	if err == nil && db == 0 && w.glConfig.profile != GLES {
+		src = gl.FRONT
+	}
+	var fb, buf, align int32
+	gl.GetIntegerv(gl.READ_FRAMEBUFFER_BINDING, &fb)
+	gl.GetIntegerv(gl.PACK_ALIGNMENT, &align)
+	gl.BindFramebuffer(gl.READ_FRAMEBUFFER, 0)
+	gl.GetIntegerv(gl.READ_BUFFER, &buf)
+	gl.ReadBuffer(src)
+	gl.PixelStorei(gl.PACK_ALIGNMENT, 4)
+
+	gl.ReadPixels(0, 0, sx, sy, gl.RGBA, gl.UNSIGNED_BYTE, unsafe.Pointer(&m.Pix[0]))
+	e := gl.GetError()
+
+	gl.PixelStorei(gl.PACK_ALIGNMENT, align)
+	gl.ReadBuffer(gl.Enum(buf))
+	gl.BindFramebuffer(gl.READ_FRAMEBUFFER, uint32(fb))
+
+	if e != gl.NO_ERROR {
+		return nil, fmt.Errorf("window.Screenshot: glReadPixels failed (error 0x%04X)", e)
+	}
+
+	// OpenGL rows go from bottom to top
+	row := make([]byte, m.Stride)
+	for y := 0; y < int(sy)/2; y++ {
+		a := m.Pix[y*m.Stride : (y+1)*m.Stride]
+		b := m.Pix[(int(sy)-1-y)*m.Stride : (int(sy)-y)*m.Stride]
+		copy(row, a)
+		copy(a, b)
+		copy(b, row)
+	}
+	for i := 3; i < len(m.Pix); i += 4 {
+		m.Pix[i] = 0xFF
+	}
+
+	return m, nil
+}
+
+// SaveScreenshot writes the content of the back buffer of the window to a PNG
+// file (see Screenshot).
+func (w *Window) SaveScreenshot(path string) error {
+	m, err := w.Screenshot()
+	if err != nil {
+		return err
+	}
+	return SavePNG(path, m)
+}
+
+// SavePNG writes an image to a PNG file.
+func SavePNG(path string, m image.Image) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("window.SavePNG: %w", err)
+	}
+	err = png.Encode(f, m)
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("window.SavePNG: %w", err)
+	}
+	err = f.Close()
+	if err != nil {
+		return fmt.Errorf("window.SavePNG: %w", err)
+	}
+	return nil
+}
+
+// capture saves a screenshot in the capture directory, if the current frame is
+// one of those selected by the Capture option. It must be called before the
+// buffers are swapped.
+func (w *Window) capture() {
+	if w.captureEvery <= 0 {
+		return
+	}
+	n := w.captureFrame
+	w.captureFrame++
+	if n%uint64(w.captureEvery) != 0 {
+		return
+	}
+
+	p := filepath.Join(w.captureDir, fmt.Sprintf("frame%06d.png", n))
+	err := w.SaveScreenshot(p)
+	if err != nil {
+		// Stop capturing rather than logging the same error at each frame
+		log.Printf("window: capture stopped: %v", err)
+		w.captureEvery = 0
+	}
+}
@@ -0,0 +1,67 @@
+package window
+
+import (
+	"image/color"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cozely/platform/internal/gl"
+)
+
+func TestScreenshot(t *testing.T) {
+	w := newHeadless(t)
+	defer closeHeadless(w)
+
+	// Green bottom half, red top half (in OpenGL coordinates)
+	gl.ClearColor(0, 1, 0, 1)
+	gl.Clear(gl.COLOR_BUFFER_BIT)
+	gl.Enable(gl.SCISSOR_TEST)
+	gl.Scissor(0, 24, 64, 24)
+	gl.ClearColor(1, 0, 0, 0.5)
+	gl.Clear(gl.COLOR_BUFFER_BIT)
+	gl.Disable(gl.SCISSOR_TEST)
+
+	m, err := w.Screenshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := m.Bounds(); b.Dx() != 64 || b.Dy() != 48 {
+		t.Fatalf("screenshot size is %v, want 64x48", b.Size())
+	}
+
+	red := color.RGBA{255, 0, 0, 255}
+	green := color.RGBA{0, 255, 0, 255}
+	if c := m.RGBAAt(0, 0); c != red {
+		t.Errorf("top left pixel = %v, want %v", c, red)
+	}
+	if c := m.RGBAAt(63, 47); c != green {
+		t.Errorf("bottom right pixel = %v, want %v", c, green)
+	}
+}
+
+func TestCapture(t *testing.T) {
+	dir, err := ioutil.TempDir("", "window-capture")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	w := newHeadless(t, Capture(dir, 2))
+	defer closeHeadless(w)
+
+	for i := 0; i < 5; i++ {
+		gl.ClearColor(0, 0, 1, 1)
+		gl.Clear(gl.COLOR_BUFFER_BIT)
+		w.Present()
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.png"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 3 {
+		t.Errorf("%d captured frames, want 3: %v", len(files), files)
+	}
+}
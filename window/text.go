@@ -0,0 +1,65 @@
+package window
+
+import (
+	"bytes"
+
+	"github.com/cozely/platform/internal/sdl"
+)
+
+// StartTextInput enables the text input events (TextInput and TextEditing),
+// and shows the on-screen keyboard or the input method, if any. Text input is
+// disabled by default, as it can interfere with keyboard controls; it should
+// be enabled while a text field has the focus.
+//
+// Text input is global: the events are sent for the window with keyboard
+// focus.
+func (w *Window) StartTextInput() {
+	checkThread("window.StartTextInput")
+	sdl.StartTextInput()
+}
+
+// StopTextInput disables the text input events.
+func (w *Window) StopTextInput() {
+	checkThread("window.StopTextInput")
+	sdl.StopTextInput()
+}
+
+// TextInputActive returns true if text input is enabled.
+func (w *Window) TextInputActive() bool {
+	checkThread("window.TextInputActive")
+	return sdl.IsTextInputActive()
+}
+
+// SetTextInputRect indicates the area of the text field being edited, in
+// window pixels, so that the candidate list of the input method can be shown
+// next to it.
+func (w *Window) SetTextInputRect(position, size Coord) {
+	checkThread("window.SetTextInputRect")
+	r := sdl.Rect{X: position.X, Y: position.Y, W: size.X, H: size.Y}
+	sdl.SetTextInputRect(&r)
+}
+
+func translateText(e *sdl.Event) Event {
+	switch e.Type {
+	case sdl.EventTextInput:
+		t := e.Text()
+		return TextInput{WindowID: t.WindowID, Text: nullTerminated(t.Text[:])}
+	case sdl.EventTextEditing:
+		t := e.Edit()
+		return TextEditing{
+			WindowID:  t.WindowID,
+			Text:      nullTerminated(t.Text[:]),
+			Cursor:    int(t.Start),
+			Selection: int(t.Length),
+		}
+	}
+	return nil
+}
+
+// nullTerminated returns the string stored in b, up to the first null byte.
+func nullTerminated(b []byte) string {
+	if i := bytes.IndexByte(b, 0); i >= 0 {
+		b = b[:i]
+	}
+	return string(b)
+}
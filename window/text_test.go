@@ -0,0 +1,43 @@
+package window
+
+import (
+	"testing"
+
+	"github.com/cozely/platform/internal/sdl"
+)
+
+func TestTranslateText(t *testing.T) {
+	var e sdl.Event
+	e.Type = sdl.EventTextInput
+	copy(e.Text().Text[:], "été\x00garbage")
+	if ev, ok := translate(&e).(TextInput); !ok || ev.Text != "été" {
+		t.Errorf("translate(TextInput) = %#v", translate(&e))
+	}
+
+	e = sdl.Event{Type: sdl.EventTextEditing}
+	te := e.Edit()
+	copy(te.Text[:], "日本語")
+	te.Start, te.Length = 1, 2
+	want := TextEditing{Text: "日本語", Cursor: 1, Selection: 2}
+	if ev := translate(&e); ev != want {
+		t.Errorf("translate(TextEditing) = %#v, want %#v", ev, want)
+	}
+}
+
+func TestTextInput(t *testing.T) {
+	w := newHeadless(t)
+	defer closeHeadless(w)
+
+	if w.TextInputActive() {
+		t.Errorf("text input active by default")
+	}
+	w.StartTextInput()
+	w.SetTextInputRect(Coord{10, 10}, Coord{40, 12})
+	if !w.TextInputActive() {
+		t.Errorf("text input not active after StartTextInput")
+	}
+	w.StopTextInput()
+	if w.TextInputActive() {
+		t.Errorf("text input still active after StopTextInput")
+	}
+}
@@ -5,95 +5,188 @@ package window
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/cozely/platform/internal/gl"
 	"github.com/cozely/platform/internal/sdl"
 )
 
-func setupSDL() error {
+// setupSDL initializes the video subsystem. If driver is not empty, it selects
+// the video driver, which must match the current one if the subsystem is
+// already initialized.
+func setupSDL(driver string) error {
 	if sdl.WasInit(sdl.InitVideo) == 0 {
+		if driver != "" {
+			// The hint must take precedence over SDL_VIDEODRIVER
+			sdl.SetHintWithPriority(sdl.HintVideoDriver, driver, sdl.HintOverride)
+		}
 		err := sdl.Init(sdl.InitVideo)
+		if driver != "" {
+			// Restore the environment setting for later initializations
+			sdl.SetHintWithPriority(sdl.HintVideoDriver, os.Getenv(sdl.HintVideoDriver), sdl.HintOverride)
+		}
 		if err != nil {
-			return err
+			return &SDLError{Call: "SDL_Init", Message: err.Error(), Err: ErrNoDisplay}
+		}
+		if d := sdl.GetCurrentVideoDriver(); driver != "" && d != driver {
+			sdl.QuitSubSystem(sdl.InitVideo)
+			return fmt.Errorf("video driver %q not available (got %q): %w", driver, d, ErrNoDisplay)
 		}
+		// The precise wheel amounts are not set by older versions
+		preciseWheel = sdl.GetVersion().AtLeast(2, 0, 18)
+		// SDL enables text input by default, see StartTextInput
+		sdl.StopTextInput()
+		enableDrop()
 
 		sdl.GLLoadDefaultLibrary()
+		return nil
+	}
+
+	if d := sdl.GetCurrentVideoDriver(); driver != "" && d != driver {
+		return fmt.Errorf("video already initialized with driver %q", d)
 	}
 	return nil
 }
 
-func setupGL() error {
-	if !gl.WasInit() {
-		err := gl.Init() //TODO: test if already init
-		if err != nil {
-			return err
-		}
+// videoUsers is the number of opened windows and cursors, and of calls in
+// progress that need the video subsystem without a window. The subsystem is
+// shut down when the last user is gone.
+var videoUsers int
+
+// acquireVideo initializes the video subsystem (see setupSDL), and counts a
+// new user of it. Each successful call must be matched by releaseVideo.
+func acquireVideo(driver string) error {
+	err := setupSDL(driver)
+	if err != nil {
+		return err
 	}
+	videoUsers++
+	return nil
+}
+
+// releaseVideo shuts down the video subsystem if the caller was its last user.
+func releaseVideo() {
+	videoUsers--
+	if videoUsers == 0 {
+		glLoaded = false
+		sdl.QuitSubSystem(sdl.InitVideo)
+	}
+}
+
+// glLoaded is true once the OpenGL binding has been prepared for the current
+// initialization of the video subsystem.
+var glLoaded bool
 
+// setupGL prepares the OpenGL binding. It must be called after the creation of
+// a context; the binding is reset for the first window opened after the video
+// subsystem is initialized, since the OpenGL library may have been reloaded,
+// and whenever reload is true.
+func setupGL(reload bool) error {
+	if !reload && glLoaded {
+		return nil
+	}
+	err := gl.Init()
+	if err != nil {
+		return sdlError("SDL_GL_GetProcAddress", err)
+	}
+	glLoaded = true
 	return nil
 }
 
-// Window represents a platform and its context.
+// Window represents a platform and its context. Several handles may refer to
+// the same window (see Lookup and Windows): use ID to compare them.
 type Window struct {
+	*window
+}
+
+// window is the state of an opened window, shared by all its handles.
+type window struct {
 	handle  sdl.Window
 	context sdl.GLContext
+	id      uint32
 
-	title         string
-	size          Coord
-	monitor       int32
-	multisample   int32
-	debug         bool
-	vsync         bool
-	fullscreen    bool
-	desktop       bool
-	hasFocus      bool
-	hasMouseFocus bool
-	opened        bool
+	title          string
+	size           Coord
+	mouse          Coord
+	monitor        int32
+	fullscreenMode *DisplayMode
+	glConfig       contextConfig
+	debug          bool
+	debugHandler   func(DebugMessage)
+	debugFilter    Severity
+	debugPanic     bool
+	headless       bool
+	highDPI        bool
+	scale          float32
+	captureDir     string
+	captureEvery   int
+	captureFrame   uint64
+	frames         frameTimer
+	vsync          bool
+	fullscreen     bool
+	desktop        bool
+	hasFocus       bool
+	hasMouseFocus  bool
+	opened         bool
+	closed         bool
+
+	// handles is the number of handles not yet garbage collected
+	handles int
 }
 
 // New creates a window and its associated context.
 func New(o ...Option) (*Window, error) {
-	var err error
+	checkThread("window.New")
+	closeLeaked()
 
-	err = setupSDL()
-	if err != nil {
-		return nil, fmt.Errorf("internal.New: %v", err)
-	}
+	var err error
 
-	w := Window{
-		title: "Untitled",
-		size:  Coord{X: 1280, Y: 720},
-		debug: true,
-	}
+	w := &Window{&window{
+		title:        "Untitled",
+		size:         Coord{X: 1280, Y: 720},
+		debug:        true,
+		debugHandler: LogDebugMessage,
+		debugFilter:  SeverityLow,
+		debugPanic:   debugPanicDefault,
+		glConfig: contextConfig{
+			profile:   GLES,
+			major:     3,
+			minor:     0,
+			depthBits: 16,
+		},
+	}}
 	for _, o := range o {
-		err := o(&w)
+		err := o(w)
 		if err != nil {
-			return nil, fmt.Errorf("internal.New: %v", err)
+			return nil, fmt.Errorf("window.New: %w", err)
 		}
 	}
 
-	sdl.GLSetAttribute(sdl.GLContextMajorVersion, 3)
-	sdl.GLSetAttribute(sdl.GLContextMinorVersion, 0)
-	sdl.GLSetAttribute(sdl.GLContextProfileMask, sdl.GLContextProfileES)
-	// sdl.GLSetAttribute(sdl.GLDepthSize, 16)
-	sdl.GLSetAttribute(sdl.GLDoubleBuffer, 1)
-	multisample := int32(0)
-	if multisample > 0 {
-		sdl.GLSetAttribute(sdl.GLMultisampleBuffers, 1)
-		sdl.GLSetAttribute(sdl.GLMultisampleSamples, multisample)
+	driver := ""
+	if w.headless {
+		driver = "offscreen"
 	}
-
-	if w.debug {
-		sdl.GLSetAttribute(sdl.GLContextFlags, sdl.GLContextDebugFlag)
+	err = acquireVideo(driver)
+	if err != nil {
+		return nil, fmt.Errorf("window.New: %w", err)
 	}
 
-	flags := sdl.WindowOpenGL | sdl.WindowResizable
-	if w.fullscreen {
-		if w.desktop {
-			flags |= sdl.WindowFullscreenDesktop
-		} else {
-			flags |= sdl.WindowFullscreen
+	// Release everything on failure
+	ok := false
+	defer func() {
+		if !ok {
+			w.release()
 		}
+	}()
+
+	err = w.setFramebufferAttributes()
+	if err != nil {
+		return nil, fmt.Errorf("window.New: %w", err)
+	}
+
+	flags := sdl.WindowOpenGL | sdl.WindowResizable | w.fullscreenFlags()
+	if w.highDPI {
+		flags |= sdl.WindowAllowHighdpi
 	}
 
 	w.handle, err = sdl.CreateWindow(
@@ -105,56 +198,130 @@ func New(o ...Option) (*Window, error) {
 		flags,
 	)
 	if err != nil {
-		return nil, err
-	}
-
-	w.context, err = sdl.GLCreateContext(w.handle)
-	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("window.New: %w", sdlError("SDL_CreateWindow", err))
 	}
+	w.id = sdl.GetWindowID(w.handle)
 
-	if w.vsync {
-		err = sdl.GLSetSwapInterval(-1)
-		if err != nil {
-			err = sdl.GLSetSwapInterval(1)
-			if err != nil {
-				return nil, err
-			}
-		}
-	} else {
-		err = sdl.GLSetSwapInterval(0)
+	if w.fullscreenMode != nil {
+		m := w.fullscreenMode.toSDL()
+		err = sdl.SetWindowDisplayMode(w.handle, &m)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("window.New: %w", sdlError("SDL_SetWindowDisplayMode", err))
 		}
 	}
+	// The size may have been adjusted by the system
+	w.size = w.windowSize()
+	w.updateRefresh()
 
-	err = setupGL()
+	err = w.createContext()
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("window.New: %w", err)
 	}
 
-	c := struct {
-		R float32
-		G float32
-		B float32
-		A float32
-	}{R: 1.0, G: 0.5, B: 0.5, A: 1.0}
-	gl.ClearBufferv(gl.COLOR, 0, &c)
+	err = setupGL(false)
+	if err != nil {
+		return nil, fmt.Errorf("window.New: %w", err)
+	}
+	w.setupDebug()
 
+	c := [4]float32{1.0, 0.5, 0.5, 1.0}
+	gl.ClearBufferfv(gl.COLOR, 0, &c[0])
+
+	w.checkScale()
+	register(w)
 	w.opened = true
+	ok = true
+
+	return w, nil
+}
+
+// setSwapInterval enables or disables vsync for the context of the window,
+// which must be current. Adaptive vsync is used when available.
+func (w *Window) setSwapInterval() error {
+	if !w.vsync {
+		return sdl.GLSetSwapInterval(0)
+	}
+	err := sdl.GLSetSwapInterval(-1)
+	if err != nil {
+		return sdl.GLSetSwapInterval(1)
+	}
+	return nil
+}
+
+// fullscreenFlags returns the window flags corresponding to the fullscreen
+// settings.
+func (w *Window) fullscreenFlags() sdl.WindowFlags {
+	switch {
+	case !w.fullscreen:
+		return 0
+	case w.desktop:
+		return sdl.WindowFullscreenDesktop
+	default:
+		return sdl.WindowFullscreen
+	}
+}
 
-	return &w, nil
+// Set changes the options of an opened window. The options are applied in
+// order, and Set stops at the first error.
+func (w *Window) Set(o ...Option) error {
+	checkThread("window.Set")
+	if w.closed {
+		return ErrClosed
+	}
+	for _, o := range o {
+		err := o(w)
+		if err != nil {
+			return fmt.Errorf("window.Set: %w", err)
+		}
+	}
+	return nil
 }
 
 // Present asks the system to display the content of the window (e.g. by
 // swapping OpenGL buffers).
-func (w *Window) Present() {
+func (w *Window) Present() error {
+	checkThread("window.Present")
+	err := w.MakeCurrent()
+	if err != nil {
+		return err
+	}
+	w.capture()
 	sdl.GLSwapWindow(w.handle)
+	w.endFrame()
+	return nil
 }
 
-// Close destroys the window.
-func (w *Window) Close() {
-	sdl.DestroyWindow(w.handle)
+// Close destroys the window and its OpenGL context. When the last window is
+// closed, the video subsystem is shut down. Closing a window more than once
+// has no effect, and returns ErrClosed.
+func (w *Window) Close() error {
+	checkThread("window.Close")
+	if w.closed {
+		return ErrClosed
+	}
+	unregister(w)
+	w.release()
+	return nil
+}
+
+// release frees the system resources of the window. It is used by Close, and
+// by New on failure.
+func (w *Window) release() {
+	if current == w.id {
+		current = 0
+	}
+	if w.context != (sdl.GLContext{}) {
+		sdl.GLDeleteContext(w.context)
+		w.context = sdl.GLContext{}
+	}
+	if w.handle != (sdl.Window{}) {
+		sdl.DestroyWindow(w.handle)
+		w.handle = sdl.Window{}
+	}
+	w.opened = false
+	w.closed = true
+
+	releaseVideo()
 }
 
 // HasFocus returns true if the window has focus.
@@ -167,7 +334,8 @@ func (w *Window) HasMouseFocus() bool {
 	return w.hasMouseFocus
 }
 
-// Size returns the size of the window in (screen) pixels.
+// Size returns the size of the window in (screen) pixels. The size of the
+// framebuffer may differ (see DrawableSize).
 func (w *Window) Size() Coord {
 	return w.size
 }
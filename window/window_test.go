@@ -0,0 +1,136 @@
+package window
+
+import (
+	"runtime"
+	"testing"
+	"unsafe"
+
+	"github.com/cozely/platform/internal/gl"
+	"github.com/cozely/platform/internal/sdl"
+)
+
+// newHeadless opens a headless window for the test, or skips the test if
+// the offscreen driver is not available. The goroutine stays locked to its
+// thread until the window is closed with closeHeadless.
+func newHeadless(t *testing.T, o ...Option) *Window {
+	t.Helper()
+
+	// The context is current on the thread that created it
+	runtime.LockOSThread()
+
+	w, err := New(append([]Option{Headless(true), Size(64, 48)}, o...)...)
+	if err != nil {
+		runtime.UnlockOSThread()
+		t.Skipf("headless window not available: %v", err)
+	}
+	return w
+}
+
+// closeHeadless closes a window opened by newHeadless, and unlocks the thread.
+func closeHeadless(w *Window) {
+	w.Close()
+	runtime.UnlockOSThread()
+}
+
+// readPixel returns the color of the pixel at x, y in the default framebuffer.
+func readPixel(x, y int32) [4]uint8 {
+	var p [4]uint8
+	gl.PixelStorei(gl.PACK_ALIGNMENT, 1)
+	gl.ReadPixels(x, y, 1, 1, gl.RGBA, gl.UNSIGNED_BYTE, unsafe.Pointer(&p[0]))
+	return p
+}
+
+func TestNew(t *testing.T) {
+	w := newHeadless(t, Title("TestNew"))
+	defer closeHeadless(w)
+
+	if s := w.Size(); s != (Coord{64, 48}) {
+		t.Errorf("Size() = %v, want {64 48}", s)
+	}
+	if lookup(w.id) != w.window {
+		t.Errorf("window %d not registered", w.id)
+	}
+
+	c, err := w.ContextInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Version == "" || c.Major < 3 {
+		t.Errorf("unexpected context: %v (version %q)", c, c.Version)
+	}
+}
+
+func TestClear(t *testing.T) {
+	w := newHeadless(t)
+	defer closeHeadless(w)
+
+	c := [4]float32{0, 1, 0, 1}
+	gl.ClearBufferfv(gl.COLOR, 0, &c[0])
+	if p := readPixel(10, 10); p != [4]uint8{0, 255, 0, 255} {
+		t.Errorf("after ClearBufferfv, pixel = %v, want green", p)
+	}
+
+	gl.ClearColor(0, 0, 1, 1)
+	gl.Clear(gl.COLOR_BUFFER_BIT)
+	if p := readPixel(63, 47); p != [4]uint8{0, 0, 255, 255} {
+		t.Errorf("after Clear, pixel = %v, want blue", p)
+	}
+
+	if e := gl.GetError(); e != gl.NO_ERROR {
+		t.Errorf("GetError() = 0x%04X", e)
+	}
+
+	w.Present()
+}
+
+func TestClose(t *testing.T) {
+	w := newHeadless(t)
+	defer runtime.UnlockOSThread()
+	id := w.id
+	err := w.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if lookup(id) != nil {
+		t.Errorf("window %d still registered after Close", id)
+	}
+	if err := w.Close(); err != ErrClosed {
+		t.Errorf("second Close() = %v, want ErrClosed", err)
+	}
+	if err := w.Present(); err != ErrClosed {
+		t.Errorf("Present() after Close = %v, want ErrClosed", err)
+	}
+	if err := w.Set(Title("closed")); err != ErrClosed {
+		t.Errorf("Set() after Close = %v, want ErrClosed", err)
+	}
+	if _, err := w.Screenshot(); err != ErrClosed {
+		t.Errorf("Screenshot() after Close = %v, want ErrClosed", err)
+	}
+	if len(Windows()) == 0 && sdl.WasInit(sdl.InitVideo) != 0 {
+		t.Errorf("video subsystem still initialized after closing the last window")
+	}
+}
+
+func TestReleaseVideo(t *testing.T) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	if len(Windows()) != 0 {
+		t.Skip("windows already opened")
+	}
+
+	// The results depend on the system
+	calls := map[string]func(){
+		"Displays":         func() { Displays() },
+		"Clipboard":        func() { Clipboard() },
+		"HasClipboardText": func() { HasClipboardText() },
+		"PrimarySelection": func() { PrimarySelection() },
+		"ShowCursor":       func() { ShowCursor(true) },
+	}
+	for name, f := range calls {
+		f()
+		if videoUsers != 0 || sdl.WasInit(sdl.InitVideo) != 0 {
+			t.Errorf("video subsystem still initialized after %s (%d users)", name, videoUsers)
+		}
+	}
+}
@@ -0,0 +1,285 @@
+// Package windowtest provides golden image tests for rendering code built on
+// package window.
+//
+// A test renders a frame in a headless window, and compares it to a PNG file
+// stored in the testdata directory of the package:
+//
+//	func TestTriangle(t *testing.T) {
+//		windowtest.Golden(t, "triangle", func(w *window.Window) {
+//			drawTriangle()
+//		}, windowtest.Tolerance(2))
+//	}
+//
+// Running the tests with the -update flag writes the golden files instead of
+// comparing them. Tests are skipped when no headless window can be opened.
+package windowtest
+
+import (
+	"flag"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"math"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/cozely/platform/window"
+)
+
+var update = flag.Bool("update", false, "update the golden images of windowtest")
+
+// An Option configures the rendering or the comparison of a golden test.
+type Option func(*config)
+
+type config struct {
+	size          window.Coord
+	dir           string
+	tolerance     uint8
+	perceptual    float64
+	maxDiffPixels int
+	window        []window.Option
+}
+
+func newConfig(o []Option) config {
+	c := config{
+		size: window.Coord{X: 64, Y: 48},
+		dir:  "testdata",
+	}
+	for _, o := range o {
+		o(&c)
+	}
+	return c
+}
+
+// Size sets the size of the rendered frame (default 64x48).
+func Size(x, y int32) Option {
+	return func(c *config) {
+		c.size = window.Coord{X: x, Y: y}
+	}
+}
+
+// Dir sets the directory of the golden files (default "testdata").
+func Dir(path string) Option {
+	return func(c *config) {
+		c.dir = path
+	}
+}
+
+// Tolerance sets the maximum difference allowed on each channel of a pixel
+// (default 0).
+func Tolerance(n uint8) Option {
+	return func(c *config) {
+		c.tolerance = n
+	}
+}
+
+// Perceptual accepts the pixels whose color difference (CIE76 delta E, where
+// 2.3 is about the smallest noticeable difference) is at most d, even if they
+// are not within the per-channel tolerance. It is disabled if d is zero (the
+// default).
+func Perceptual(d float64) Option {
+	return func(c *config) {
+		c.perceptual = d
+	}
+}
+
+// MaxDiffPixels sets the number of different pixels allowed before the
+// comparison fails (default 0).
+func MaxDiffPixels(n int) Option {
+	return func(c *config) {
+		c.maxDiffPixels = n
+	}
+}
+
+// WindowOptions adds options to the headless window used for rendering.
+func WindowOptions(o ...window.Option) Option {
+	return func(c *config) {
+		c.window = append(c.window, o...)
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Render opens a headless window, calls frame to draw in it, and returns the
+// result. The test is skipped if no headless window can be opened.
+func Render(t testing.TB, frame func(w *window.Window), o ...Option) *image.RGBA {
+	t.Helper()
+	c := newConfig(o)
+
+	// The context is current on the thread that created it
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	wo := append([]window.Option{window.Headless(true), window.Size(c.size.X, c.size.Y)}, c.window...)
+	w, err := window.New(wo...)
+	if err != nil {
+		t.Skipf("windowtest: headless window not available: %v", err)
+	}
+	defer w.Close()
+
+	frame(w)
+
+	m, err := w.Screenshot()
+	if err != nil {
+		t.Fatalf("windowtest: %v", err)
+	}
+	return m
+}
+
+// Golden renders a frame with Render, and compares it with the golden file
+// name.png. On failure, the rendered image and an image showing the different
+// pixels are written next to the golden file, as name.got.png and
+// name.diff.png. With the -update flag, the golden file is written instead.
+func Golden(t testing.TB, name string, frame func(w *window.Window), o ...Option) {
+	t.Helper()
+	c := newConfig(o)
+
+	got := Render(t, frame, o...)
+
+	path := filepath.Join(c.dir, name+".png")
+	if *update {
+		err := os.MkdirAll(c.dir, 0755)
+		if err == nil {
+			err = window.SavePNG(path, got)
+		}
+		if err != nil {
+			t.Fatalf("windowtest: %v", err)
+		}
+		t.Logf("windowtest: updated %s", path)
+		return
+	}
+
+	want, err := LoadPNG(path)
+	if err != nil {
+		t.Fatalf("windowtest: %v (run the tests with -update to create it)", err)
+	}
+
+	diff, n, err := Compare(got, want, o...)
+	if err != nil {
+		t.Fatalf("windowtest: %s: %v", name, err)
+	}
+	if n <= c.maxDiffPixels {
+		return
+	}
+
+	gotPath := filepath.Join(c.dir, name+".got.png")
+	diffPath := filepath.Join(c.dir, name+".diff.png")
+	err = window.SavePNG(gotPath, got)
+	if err == nil {
+		err = window.SavePNG(diffPath, diff)
+	}
+	if err != nil {
+		t.Errorf("windowtest: %v", err)
+	}
+	t.Errorf("windowtest: %s: %d pixels differ from the golden image (see %s and %s)", name, n, gotPath, diffPath)
+}
+
+// LoadPNG reads an image from a PNG file.
+func LoadPNG(path string) (*image.RGBA, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	m, err := png.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	if m, ok := m.(*image.RGBA); ok && m.Bounds().Min == (image.Point{}) {
+		return m, nil
+	}
+	b := m.Bounds()
+	r := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(r, r.Bounds(), m, b.Min, draw.Src)
+	return r, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// Compare compares two images of the same size, with the Tolerance and
+// Perceptual options. It returns the number of different pixels, and an image
+// showing them in red over a faded copy of want.
+func Compare(got, want image.Image, o ...Option) (*image.RGBA, int, error) {
+	c := newConfig(o)
+
+	gb, wb := got.Bounds(), want.Bounds()
+	if gb.Size() != wb.Size() {
+		return nil, 0, fmt.Errorf("size is %v, want %v", gb.Size(), wb.Size())
+	}
+
+	diff := image.NewRGBA(image.Rect(0, 0, wb.Dx(), wb.Dy()))
+	n := 0
+	for y := 0; y < wb.Dy(); y++ {
+		for x := 0; x < wb.Dx(); x++ {
+			g := color.RGBAModel.Convert(got.At(gb.Min.X+x, gb.Min.Y+y)).(color.RGBA)
+			w := color.RGBAModel.Convert(want.At(wb.Min.X+x, wb.Min.Y+y)).(color.RGBA)
+			if c.similar(g, w) {
+				// Faded grayscale version of the expected pixel
+				l := uint8(192 + luminance(w)/4)
+				diff.SetRGBA(x, y, color.RGBA{l, l, l, 255})
+				continue
+			}
+			n++
+			diff.SetRGBA(x, y, color.RGBA{255, 0, 0, 255})
+		}
+	}
+	return diff, n, nil
+}
+
+func (c *config) similar(a, b color.RGBA) bool {
+	if absDiff(a.R, b.R) <= c.tolerance && absDiff(a.G, b.G) <= c.tolerance &&
+		absDiff(a.B, b.B) <= c.tolerance && absDiff(a.A, b.A) <= c.tolerance {
+		return true
+	}
+	return c.perceptual > 0 && a.A == b.A && deltaE(a, b) <= c.perceptual
+}
+
+func absDiff(a, b uint8) uint8 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+func luminance(c color.RGBA) float64 {
+	return 0.2126*float64(c.R) + 0.7152*float64(c.G) + 0.0722*float64(c.B)
+}
+
+// deltaE returns the CIE76 color difference between two sRGB colors.
+func deltaE(a, b color.RGBA) float64 {
+	l1, a1, b1 := lab(a)
+	l2, a2, b2 := lab(b)
+	return math.Sqrt((l1-l2)*(l1-l2) + (a1-a2)*(a1-a2) + (b1-b2)*(b1-b2))
+}
+
+// lab converts an sRGB color to CIELAB (D65 white point).
+func lab(c color.RGBA) (l, a, b float64) {
+	r, g, bl := linear(c.R), linear(c.G), linear(c.B)
+
+	x := (0.4124*r + 0.3576*g + 0.1805*bl) / 0.95047
+	y := 0.2126*r + 0.7152*g + 0.0722*bl
+	z := (0.0193*r + 0.1192*g + 0.9505*bl) / 1.08883
+
+	fx, fy, fz := labf(x), labf(y), labf(z)
+	return 116*fy - 16, 500 * (fx - fy), 200 * (fy - fz)
+}
+
+func linear(v uint8) float64 {
+	c := float64(v) / 255
+	if c <= 0.04045 {
+		return c / 12.92
+	}
+	return math.Pow((c+0.055)/1.055, 2.4)
+}
+
+func labf(t float64) float64 {
+	if t > 216.0/24389.0 {
+		return math.Cbrt(t)
+	}
+	return t*24389.0/(27*116) + 16.0/116
+}
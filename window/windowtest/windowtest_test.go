@@ -0,0 +1,67 @@
+package windowtest
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/cozely/platform/internal/gl"
+	"github.com/cozely/platform/window"
+)
+
+func uniform(c color.RGBA) *image.RGBA {
+	m := image.NewRGBA(image.Rect(0, 0, 8, 8))
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			m.SetRGBA(x, y, c)
+		}
+	}
+	return m
+}
+
+func TestCompare(t *testing.T) {
+	a := uniform(color.RGBA{100, 100, 100, 255})
+	b := uniform(color.RGBA{100, 100, 100, 255})
+	b.SetRGBA(1, 1, color.RGBA{103, 100, 100, 255})
+	b.SetRGBA(2, 2, color.RGBA{200, 100, 100, 255})
+
+	cases := []struct {
+		options []Option
+		want    int
+	}{
+		{nil, 2},
+		{[]Option{Tolerance(3)}, 1},
+		{[]Option{Tolerance(200)}, 0},
+		{[]Option{Perceptual(2.3)}, 1},
+		{[]Option{Perceptual(100)}, 0},
+	}
+	for i, c := range cases {
+		diff, n, err := Compare(a, b, c.options...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != c.want {
+			t.Errorf("case %d: %d different pixels, want %d", i, n, c.want)
+		}
+		if c.want == 2 && diff.RGBAAt(2, 2) != (color.RGBA{255, 0, 0, 255}) {
+			t.Errorf("case %d: different pixel not marked in the diff image", i)
+		}
+	}
+
+	_, _, err := Compare(a, image.NewRGBA(image.Rect(0, 0, 4, 4)))
+	if err == nil {
+		t.Errorf("Compare of images of different sizes did not fail")
+	}
+}
+
+func TestGolden(t *testing.T) {
+	Golden(t, "halves", func(w *window.Window) {
+		gl.ClearColor(0, 1, 0, 1)
+		gl.Clear(gl.COLOR_BUFFER_BIT)
+		gl.Enable(gl.SCISSOR_TEST)
+		gl.Scissor(0, 24, 64, 24)
+		gl.ClearColor(1, 0, 0, 1)
+		gl.Clear(gl.COLOR_BUFFER_BIT)
+		gl.Disable(gl.SCISSOR_TEST)
+	}, Tolerance(1))
+}